@@ -1,9 +1,12 @@
 package main
 
 import (
+	"errors"
 	"main/pkg"
 	configPkg "main/pkg/config"
 	"main/pkg/logger"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -13,50 +16,161 @@ var (
 	version = "unknown"
 )
 
-func Execute(inputConfig configPkg.InputConfig, args []string) {
-	if len(args) == 0 || args[0] == "" {
-		inputConfig.RPCHost = "http://localhost:26657"
-	} else {
-		inputConfig.RPCHost = args[0]
+func Execute(inputConfig configPkg.InputConfig, args []string, isFlagChanged func(name string) bool) {
+	if len(args) > 0 && args[0] != "" {
+		inputConfig.RPCHosts = strings.Split(args[0], ",")
 	}
 
-	config, err := configPkg.ParseAndValidateConfig(inputConfig)
+	config, chains, err := ParseConfig(inputConfig, isFlagChanged)
 	if err != nil {
 		panic(err)
 	}
 
-	app := pkg.NewApp(config, version)
+	app := pkg.NewApp(config, chains, version)
 	app.Start()
 }
 
+func ExecuteSnapshot(
+	inputConfig configPkg.InputConfig,
+	args []string,
+	isFlagChanged func(name string) bool,
+	format string,
+) {
+	if len(args) > 0 && args[0] != "" {
+		inputConfig.RPCHosts = strings.Split(args[0], ",")
+	}
+
+	config, _, err := ParseConfig(inputConfig, isFlagChanged)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := pkg.Snapshot(config, format, os.Stdout); err != nil {
+		logger.GetDefaultLogger().Fatal().Err(err).Msg("Could not take snapshot")
+	}
+}
+
+func ParseConfig(
+	inputConfig configPkg.InputConfig,
+	isFlagChanged func(name string) bool,
+) (*configPkg.Config, []*configPkg.Config, error) {
+	if inputConfig.ConfigPath == "" {
+		if inputConfig.Profile != "" {
+			return nil, nil, errors.New("profile is set, but config is not provided")
+		}
+
+		if len(inputConfig.RPCHosts) == 0 {
+			inputConfig.RPCHosts = []string{configPkg.DefaultRPCHost}
+		}
+
+		config, err := configPkg.ParseAndValidateConfig(inputConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return config, []*configPkg.Config{config}, nil
+	}
+
+	fileConfig, err := configPkg.LoadFileConfig(inputConfig.ConfigPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fileConfig.ParseAndValidate(inputConfig, isFlagChanged)
+}
+
 func main() {
 	var config configPkg.InputConfig
 
 	rootCmd := &cobra.Command{
-		Use:     "tmtop [RPC host URL]",
+		Use:     "tmtop [RPC host URLs, comma-separated]",
 		Long:    "Observe the pre-voting status of any Tendermint-based blockchain.",
 		Version: version,
 		Args:    cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			Execute(config, args)
+			Execute(config, args, cmd.Flags().Changed)
+		},
+	}
+
+	var listenAddress string
+
+	exporterCmd := &cobra.Command{
+		Use:   "exporter [RPC host URLs, comma-separated]",
+		Short: "Run without UI, exposing the consensus state as Prometheus metrics",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			config.ListenAddress = listenAddress
+			Execute(config, args, cmd.Flags().Changed)
+		},
+	}
+
+	exporterCmd.Flags().StringVar(&listenAddress, "listen-address", ":9500", "Address to expose metrics on")
+	rootCmd.AddCommand(exporterCmd)
+
+	replayCmd := &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay a consensus session recorded with --record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			config.ReplayFile = args[0]
+			Execute(config, nil, cmd.Flags().Changed)
 		},
 	}
 
-	rootCmd.PersistentFlags().StringVar(&config.ProviderRPCHost, "provider-rpc-host", "", "Provider chain RPC host URL")
+	rootCmd.AddCommand(replayCmd)
+
+	var snapshotFormat string
+
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot [RPC host URLs, comma-separated]",
+		Short: "Print the current consensus state once and exit",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteSnapshot(config, args, cmd.Flags().Changed, snapshotFormat)
+		},
+	}
+
+	snapshotCmd.Flags().StringVar(&snapshotFormat, "output", pkg.SnapshotFormatJSON, "Output format. Allowed values are: 'json', 'text'")
+	rootCmd.AddCommand(snapshotCmd)
+
+	rootCmd.PersistentFlags().StringVar(&config.ConfigPath, "config", "", "Path to a YAML config file with chain profiles")
+	rootCmd.PersistentFlags().StringVar(&config.Profile, "profile", "", "Profile from the config file to use (the first one if not set)")
+	rootCmd.PersistentFlags().StringSliceVar(&config.RPCHosts, "rpc-host", nil, "RPC host URL, can be specified multiple times for failover (same as the positional argument)")
+	rootCmd.PersistentFlags().StringSliceVar(&config.ProviderRPCHosts, "provider-rpc-host", nil, "Provider chain RPC host URL, can be specified multiple times for failover")
 	rootCmd.PersistentFlags().StringVar(&config.ConsumerID, "consumer-id", "", "Consumer ID (not chain ID!)")
 	rootCmd.PersistentFlags().DurationVar(&config.RefreshRate, "refresh-rate", time.Second, "Refresh rate")
 	rootCmd.PersistentFlags().BoolVar(&config.Verbose, "verbose", false, "Display more debug logs")
 	rootCmd.PersistentFlags().BoolVar(&config.DisableEmojis, "disable-emojis", false, "Disable emojis in output")
-	rootCmd.PersistentFlags().StringVar(&config.ChainType, "chain-type", "cosmos-rpc", "Chain type. Allowed values are: 'cosmos-rpc', 'cosmos-lcd', 'tendermint'")
+	rootCmd.PersistentFlags().StringVar(&config.ChainType, "chain-type", "cosmos-rpc", "Chain type. Allowed values are: 'cosmos-rpc', 'cosmos-lcd', 'cosmos-grpc', 'tendermint'")
 	rootCmd.PersistentFlags().DurationVar(&config.ValidatorsRefreshRate, "validators-refresh-rate", time.Minute, "Validators refresh rate")
 	rootCmd.PersistentFlags().DurationVar(&config.ChainInfoRefreshRate, "chain-info-refresh-rate", 5*time.Minute, "Chain info refresh rate")
 	rootCmd.PersistentFlags().DurationVar(&config.UpgradeRefreshRate, "upgrade-refresh-rate", 30*time.Minute, "Upgrades refresh rate")
 	rootCmd.PersistentFlags().DurationVar(&config.BlockTimeRefreshRate, "block-time-refresh-rate", 30*time.Second, "Block time refresh rate")
+	rootCmd.PersistentFlags().DurationVar(&config.NodeHealthRefreshRate, "node-health-refresh-rate", 10*time.Second, "Node health (peers and mempool) refresh rate")
+	rootCmd.PersistentFlags().DurationVar(&config.RoundStateRefreshRate, "round-state-refresh-rate", 5*time.Second, "Round state (proposal, locked/valid blocks and peers) refresh rate, it's also refreshed on every new round and proposal")
+	rootCmd.PersistentFlags().DurationVar(&config.HealthCheckRate, "health-check-rate", 30*time.Second, "RPC hosts health check rate")
+	rootCmd.PersistentFlags().DurationVar(&config.FailoverTimeout, "failover-timeout", 5*time.Second, "How long to wait for an RPC host before failing over to the next one, also used for health checks")
 	rootCmd.PersistentFlags().StringVar(&config.LCDHost, "lcd-host", "", "LCD API host URL")
+	rootCmd.PersistentFlags().StringVar(&config.ProviderLCDHost, "provider-lcd-host", "", "Provider chain LCD API host URL")
+	rootCmd.PersistentFlags().StringVar(&config.GRPCHost, "grpc-host", "", "gRPC host address (host:port)")
+	rootCmd.PersistentFlags().StringVar(&config.ProviderGRPCHost, "provider-grpc-host", "", "Provider chain gRPC host address (host:port)")
+	rootCmd.PersistentFlags().BoolVar(&config.GRPCTLS, "grpc-tls", false, "Use TLS when connecting to gRPC host")
+	rootCmd.PersistentFlags().BoolVar(&config.ProviderGRPCTLS, "provider-grpc-tls", false, "Use TLS when connecting to provider chain gRPC host")
 	rootCmd.PersistentFlags().StringVar(&config.DebugFile, "debug-file", "", "Path to file to write debug info/logs to")
 	rootCmd.PersistentFlags().Int64Var(&config.HaltHeight, "halt-height", 0, "Custom halt-height")
 	rootCmd.PersistentFlags().Uint64Var(&config.BlocksBehind, "blocks-behind", 1000, "How many blocks behind to check to calculate block time")
+	rootCmd.PersistentFlags().Uint64Var(&config.BlocksHistorySize, "blocks-history", 50, "How many latest blocks to keep signatures for in the blocks history view (0 to disable)")
+	rootCmd.PersistentFlags().Uint64Var(&config.ProposersScheduleSize, "proposers-schedule", 20, "How many next heights to predict proposers for (0 to disable)")
+	rootCmd.PersistentFlags().StringSliceVar(&config.MyValidators, "my-validator", nil, "Validator to highlight: hex address, valcons/valoper address or path to priv_validator_key.json, can be specified multiple times")
+	rootCmd.PersistentFlags().BoolVar(&config.AlertMissedVotes, "alert-missed-votes", false, "Alert when your validators have not voted after +2/3 of voting power did")
+	rootCmd.PersistentFlags().DurationVar(&config.AlertStuckHeight, "alert-stuck-height", 0, "Alert when the chain is stuck at one height for longer than this (0 to disable)")
+	rootCmd.PersistentFlags().Int64Var(&config.AlertRound, "alert-round", 0, "Alert when the round gets higher than this (0 to disable)")
+	rootCmd.PersistentFlags().StringVar(&config.AlertCommand, "alert-command", "", "Shell command to run on alerts, gets the alert as JSON on stdin and as TMTOP_ALERT_* env variables")
+	rootCmd.PersistentFlags().StringVar(&config.AlertWebhook, "alert-webhook", "", "URL to send alerts to as JSON POST requests")
+	rootCmd.PersistentFlags().BoolVar(&config.AlertBell, "alert-bell", false, "Ring the terminal bell and flash the UI on alerts")
+	rootCmd.PersistentFlags().StringVar(&config.RecordFile, "record", "", "Path to a file to record all consensus, validators and status responses to, to replay them later")
 	rootCmd.PersistentFlags().StringVar(&config.Timezone, "timezone", "", "Timezone to display dates in")
+	rootCmd.PersistentFlags().BoolVar(&config.DisableWebsocket, "disable-websocket", false, "Disable subscribing to consensus events via websocket and only poll RPC")
 
 	if err := rootCmd.Execute(); err != nil {
 		logger.GetDefaultLogger().Fatal().Err(err).Msg("Could not start application")
@@ -16,14 +16,18 @@ type Aggregator struct {
 	Logger zerolog.Logger
 
 	TendermintClient *tendermint.RPC
+	WebsocketClient  *tendermint.WebsocketClient
 	DataFetcher      dataFetcher.DataFetcher
 }
 
 func NewAggregator(config *configPkg.Config, logger zerolog.Logger) *Aggregator {
+	tendermintClient := tendermint.NewRPC(config, logger)
+
 	return &Aggregator{
 		Config:           config,
 		Logger:           logger.With().Str("component", "aggregator").Logger(),
-		TendermintClient: tendermint.NewRPC(config, logger),
+		TendermintClient: tendermintClient,
+		WebsocketClient:  tendermint.NewWebsocketClient(tendermintClient.Client, logger),
 		DataFetcher:      dataFetcher.GetDataFetcher(config, logger),
 	}
 }
@@ -68,6 +72,14 @@ func (a *Aggregator) GetData() (
 	return consensus, validators, nil
 }
 
+func (a *Aggregator) GetValidatorsAtHeight(height int64) ([]types.TendermintValidator, error) {
+	return a.TendermintClient.GetValidatorsAtHeight(height)
+}
+
+func (a *Aggregator) GetDumpConsensusState() (*types.DumpConsensusStateResult, error) {
+	return a.TendermintClient.GetDumpConsensusState()
+}
+
 func (a *Aggregator) GetChainValidators() (*types.ChainValidators, error) {
 	return a.DataFetcher.GetValidators()
 }
@@ -76,6 +88,14 @@ func (a *Aggregator) GetChainInfo() (*types.TendermintStatusResponse, error) {
 	return a.TendermintClient.GetStatus()
 }
 
+func (a *Aggregator) GetNetInfo() (*types.TendermintNetInfoResult, error) {
+	return a.TendermintClient.GetNetInfo()
+}
+
+func (a *Aggregator) GetMempool() (*types.TendermintUnconfirmedTxsResult, error) {
+	return a.TendermintClient.GetUnconfirmedTxs()
+}
+
 func (a *Aggregator) GetUpgrade() (*types.Upgrade, error) {
 	return a.DataFetcher.GetUpgradePlan()
 }
@@ -83,3 +103,37 @@ func (a *Aggregator) GetUpgrade() (*types.Upgrade, error) {
 func (a *Aggregator) GetBlockTime() (time.Duration, error) {
 	return a.TendermintClient.GetBlockTime()
 }
+
+func (a *Aggregator) GetBlocksSignatures(knownHeight int64, limit int64) ([]types.BlockSignatures, error) {
+	return a.TendermintClient.GetBlocksSignatures(knownHeight, limit)
+}
+
+func (a *Aggregator) SubscribeToEvents(done chan bool) chan types.TendermintEventData {
+	go a.WebsocketClient.Listen(done)
+	return a.WebsocketClient.EventsChannel
+}
+
+func (a *Aggregator) IsWebsocketConnected() bool {
+	return a.WebsocketClient.IsConnected()
+}
+
+func (a *Aggregator) CheckHealth() {
+	a.TendermintClient.CheckHealth()
+
+	if healthChecker, ok := a.DataFetcher.(dataFetcher.HealthChecker); ok {
+		healthChecker.CheckHealth()
+	}
+}
+
+// Close releases the data fetcher's connections, if it has any.
+func (a *Aggregator) Close() error {
+	if closer, ok := a.DataFetcher.(dataFetcher.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+func (a *Aggregator) GetRPCEndpoints() types.RPCEndpoints {
+	return a.TendermintClient.Client.GetEndpoints()
+}
@@ -0,0 +1,204 @@
+package alerter
+
+import (
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/display"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// QuorumPercent is the voting power percent a round needs to proceed, after which
+// validators that haven't voted yet are considered to have missed the vote.
+const QuorumPercent = 200.0 / 3
+
+type Alerter struct {
+	Logger zerolog.Logger
+	Config *configPkg.Config
+	Sinks  []Sink
+
+	height       int64
+	heightSeenAt time.Time
+	fired        map[string]bool
+	mutex        sync.Mutex
+}
+
+func NewAlerter(config *configPkg.Config, displayWrapper display.Display, logger zerolog.Logger) *Alerter {
+	alerterLogger := logger.With().Str("component", "alerter").Logger()
+
+	sinks := make([]Sink, 0)
+
+	if config.AlertCommand != "" {
+		sinks = append(sinks, NewCommandSink(config.AlertCommand))
+	}
+
+	if config.AlertWebhook != "" {
+		sinks = append(sinks, NewWebhookSink(config.AlertWebhook))
+	}
+
+	if config.AlertBell {
+		sinks = append(sinks, NewDisplaySink(displayWrapper))
+	}
+
+	return &Alerter{
+		Logger: alerterLogger,
+		Config: config,
+		Sinks:  sinks,
+		fired:  make(map[string]bool),
+	}
+}
+
+func (a *Alerter) IsEnabled() bool {
+	if len(a.Sinks) == 0 {
+		return false
+	}
+
+	return a.Config.AlertMissedVotes || a.Config.AlertStuckHeight > 0 || a.Config.AlertRound > 0
+}
+
+// Check evaluates the alerting rules against the state and sends the alerts that
+// were not sent before to all sinks. Each alert is only sent once.
+func (a *Alerter) Check(state *types.State) {
+	if !a.IsEnabled() {
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	// Alerts are sent after the state is released, as sending them might take a while.
+	for _, alert := range a.getStateAlerts(state) {
+		key := fmt.Sprintf("%s/%d/%d/%s", alert.Type, alert.Height, alert.Round, alert.Validator)
+		if a.fired[key] {
+			continue
+		}
+
+		a.fired[key] = true
+		a.Send(alert)
+	}
+}
+
+func (a *Alerter) getStateAlerts(state *types.State) []types.Alert {
+	state.RLock()
+	defer state.RUnlock()
+
+	if state.Height == 0 {
+		return []types.Alert{}
+	}
+
+	if state.Height != a.height {
+		a.height = state.Height
+		a.heightSeenAt = time.Now()
+		a.fired = make(map[string]bool)
+	}
+
+	return a.getAlerts(state)
+}
+
+func (a *Alerter) getAlerts(state *types.State) []types.Alert {
+	alerts := make([]types.Alert, 0)
+
+	newAlert := func(alertType types.AlertType, message string) types.Alert {
+		return types.Alert{
+			Type:    alertType,
+			Chain:   a.Config.GetName(),
+			Message: message,
+			Height:  state.Height,
+			Round:   state.Round,
+			Time:    time.Now(),
+		}
+	}
+
+	// Height start time is taken from the consensus state if it's there, otherwise
+	// it's the time the height was first seen.
+	heightStartTime := state.StartTime
+	if heightStartTime.IsZero() {
+		heightStartTime = a.heightSeenAt
+	}
+
+	if a.Config.AlertStuckHeight > 0 && time.Since(heightStartTime) > a.Config.AlertStuckHeight {
+		alert := newAlert(types.AlertTypeStuckHeight, fmt.Sprintf(
+			"%s: chain is stuck at height %d for more than %s",
+			a.Config.GetName(),
+			state.Height,
+			a.Config.AlertStuckHeight,
+		))
+		alert.Round = 0
+		alerts = append(alerts, alert)
+	}
+
+	if a.Config.AlertRound > 0 && state.Round > a.Config.AlertRound {
+		alert := newAlert(types.AlertTypeHighRound, fmt.Sprintf(
+			"%s: height %d reached round %d",
+			a.Config.GetName(),
+			state.Height,
+			state.Round,
+		))
+		alert.Round = 0
+		alerts = append(alerts, alert)
+	}
+
+	if !a.Config.AlertMissedVotes || state.Validators == nil {
+		return alerts
+	}
+
+	prevotedPercent, _ := state.Validators.GetTotalVotingPowerPrevotedPercent(true).Float64()
+	precommittedPercent, _ := state.Validators.GetTotalVotingPowerPrecommittedPercent(true).Float64()
+	myValidators := state.GetMyValidators()
+
+	for _, validator := range state.GetValidatorsWithInfo() {
+		if !validator.IsMine(myValidators) {
+			continue
+		}
+
+		if prevotedPercent > QuorumPercent && validator.RoundVote.Prevote == types.VotedNil {
+			alert := newAlert(types.AlertTypeMissedPrevote, fmt.Sprintf(
+				"%s: validator %s has not prevoted at %d/%d, while %.2f%% have",
+				a.Config.GetName(),
+				validator.GetName(),
+				state.Height,
+				state.Round,
+				prevotedPercent,
+			))
+			alert.Validator = validator.Validator.Address
+			alerts = append(alerts, alert)
+		}
+
+		if precommittedPercent > QuorumPercent && validator.RoundVote.Precommit == types.VotedNil {
+			alert := newAlert(types.AlertTypeMissedPrecommit, fmt.Sprintf(
+				"%s: validator %s has not precommitted at %d/%d, while %.2f%% have",
+				a.Config.GetName(),
+				validator.GetName(),
+				state.Height,
+				state.Round,
+				precommittedPercent,
+			))
+			alert.Validator = validator.Validator.Address
+			alerts = append(alerts, alert)
+		}
+	}
+
+	return alerts
+}
+
+func (a *Alerter) Send(alert types.Alert) {
+	a.Logger.Warn().
+		Str("type", string(alert.Type)).
+		Str("message", alert.Message).
+		Msg("Firing alert")
+
+	for _, sink := range a.Sinks {
+		go func(sink Sink) {
+			if err := sink.Send(alert); err != nil {
+				a.Logger.Error().
+					Err(err).
+					Str("sink", sink.Name()).
+					Str("type", string(alert.Type)).
+					Msg("Could not send alert")
+			}
+		}(sink)
+	}
+}
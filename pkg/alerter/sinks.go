@@ -0,0 +1,129 @@
+package alerter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/pkg/display"
+	"main/pkg/types"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+const SinkTimeout = 30 * time.Second
+
+type Sink interface {
+	Name() string
+	Send(alert types.Alert) error
+}
+
+// CommandSink runs a shell command for each alert, passing the alert as JSON to its stdin
+// and its fields as TMTOP_ALERT_* environment variables.
+type CommandSink struct {
+	Command string
+}
+
+func NewCommandSink(command string) *CommandSink {
+	return &CommandSink{Command: command}
+}
+
+func (s *CommandSink) Name() string {
+	return "command"
+}
+
+func (s *CommandSink) Send(alert types.Alert) error {
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SinkTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.Command) //nolint:gosec // command is set by user
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(
+		os.Environ(),
+		"TMTOP_ALERT_TYPE="+string(alert.Type),
+		"TMTOP_ALERT_CHAIN="+alert.Chain,
+		"TMTOP_ALERT_MESSAGE="+alert.Message,
+		"TMTOP_ALERT_HEIGHT="+strconv.FormatInt(alert.Height, 10),
+		"TMTOP_ALERT_ROUND="+strconv.FormatInt(alert.Round, 10),
+		"TMTOP_ALERT_VALIDATOR="+alert.Validator,
+	)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, output)
+	}
+
+	return nil
+}
+
+// WebhookSink sends each alert as a JSON POST request.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		URL:    url,
+		Client: &http.Client{Timeout: SinkTimeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Send(alert types.Alert) error {
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "tmtop")
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if err := res.Body.Close(); err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("got bad status code from webhook: %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// DisplaySink rings the terminal bell and flashes the UI.
+type DisplaySink struct {
+	Display display.Display
+}
+
+func NewDisplaySink(displayWrapper display.Display) *DisplaySink {
+	return &DisplaySink{Display: displayWrapper}
+}
+
+func (s *DisplaySink) Name() string {
+	return "bell"
+}
+
+func (s *DisplaySink) Send(alert types.Alert) error {
+	s.Display.Alert(alert)
+	return nil
+}
@@ -1,254 +1,816 @@
 package pkg
 
 import (
-	"main/pkg/aggregator"
+	"encoding/json"
 	configPkg "main/pkg/config"
 	"main/pkg/display"
+	"main/pkg/exporter"
 	loggerPkg "main/pkg/logger"
+	"main/pkg/recorder"
 	"main/pkg/types"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 )
 
+// ProposersScheduleRoundsCount is how many next rounds of the current height to predict proposers for.
+const ProposersScheduleRoundsCount = 3
+
 type App struct {
 	Logger         zerolog.Logger
 	Version        string
-	Config         *configPkg.Config
-	Aggregator     *aggregator.Aggregator
-	DisplayWrapper *display.Wrapper
-	State          *types.State
+	DisplayWrapper display.Display
 	LogChannel     chan string
+	Recorder       *recorder.Recorder
+
+	// Chain is the displayed chain, it's replaced when switching chains.
+	Chain atomic.Pointer[Chain]
 
 	PauseChannel chan bool
 	IsPaused     bool
+
+	Player           *recorder.Player
+	ReplayChannel    chan int
+	ReplayValidators []types.TendermintValidator
+
+	Chains             []*configPkg.Config
+	SwitchChainChannel chan int
 }
 
-func NewApp(config *configPkg.Config, version string) *App {
+func NewApp(config *configPkg.Config, chains []*configPkg.Config, version string) *App {
 	logChannel := make(chan string)
 	pauseChannel := make(chan bool)
+	switchChainChannel := make(chan int)
+	replayChannel := make(chan int)
 
-	logger := loggerPkg.GetLogger(logChannel, config).
+	logger := loggerPkg.GetLogger(logChannel, config.DebugFile, config.Verbose).
 		With().
 		Str("component", "app_manager").
 		Logger()
 
-	return &App{
+	var displayWrapper display.Display
+	if config.IsExporter() {
+		displayWrapper = exporter.NewExporter(config, logger)
+	} else {
+		displayWrapper = display.NewWrapper(
+			config,
+			chains,
+			logger,
+			pauseChannel,
+			switchChainChannel,
+			replayChannel,
+			version,
+		)
+	}
+
+	var recorderInstance *recorder.Recorder
+	if config.RecordFile != "" {
+		instance, err := recorder.NewRecorder(config.RecordFile, logger)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Could not open file to record to")
+		}
+
+		recorderInstance = instance
+	}
+
+	var player *recorder.Player
+	if config.IsReplay() {
+		records, err := recorder.LoadRecords(config.ReplayFile)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Could not load records to replay")
+		}
+
+		player = recorder.NewPlayer(records)
+	}
+
+	app := &App{
 		Logger:         logger,
 		Version:        version,
-		Config:         config,
-		Aggregator:     aggregator.NewAggregator(config, logger),
-		DisplayWrapper: display.NewWrapper(config, logger, pauseChannel, version),
-		State:          types.NewState(),
+		DisplayWrapper: displayWrapper,
 		LogChannel:     logChannel,
+		Recorder:       recorderInstance,
 		PauseChannel:   pauseChannel,
 		IsPaused:       false,
+		Player:         player,
+		ReplayChannel:  replayChannel,
+
+		Chains:             chains,
+		SwitchChainChannel: switchChainChannel,
 	}
+
+	app.Chain.Store(NewChain(config, displayWrapper, logger))
+
+	return app
 }
 
 func (a *App) Start() {
-	go a.GoRefreshConsensus()
-	go a.GoRefreshValidators()
-	go a.GoRefreshChainInfo()
-	go a.GoRefreshUpgrade()
-	go a.GoRefreshBlockTime()
+	a.StartRefreshing(a.Chain.Load())
+
 	go a.DisplayLogs()
 	go a.ListenForPause()
+	go a.ListenForChainSwitch()
 
 	a.DisplayWrapper.Start()
+
+	if a.Recorder != nil {
+		if err := a.Recorder.Close(); err != nil {
+			a.Logger.Error().Err(err).Msg("Could not close the recording file")
+		}
+	}
+}
+
+func (a *App) StartRefreshing(chain *Chain) {
+	// When replaying, all data comes from the recording.
+	if a.Player != nil {
+		go a.GoReplay(chain)
+		return
+	}
+
+	go a.GoRefreshConsensus(chain)
+	go a.GoListenForEvents(chain)
+	go a.GoRefreshValidators(chain)
+	go a.GoRefreshChainInfo(chain)
+	go a.GoRefreshNodeHealth(chain)
+	go a.GoRefreshUpgrade(chain)
+	go a.GoRefreshBlockTime(chain)
+	go a.GoRefreshBlocksHistory(chain)
+	go a.GoRefreshDumpConsensusState(chain)
+	go a.GoRefreshProposersSchedule(chain)
+	go a.GoCheckHealth(chain)
+}
+
+func (a *App) SwitchChain(index int) {
+	if index < 0 || index >= len(a.Chains) {
+		a.Logger.Error().Int("index", index).Msg("Trying to switch to a chain that does not exist")
+		return
+	}
+
+	// The previous chain's goroutines might still be running after being stopped,
+	// but they only use the previous chain's data, which is not displayed anymore.
+	a.Chain.Load().Stop()
+
+	chain := NewChain(a.Chains[index], a.DisplayWrapper, a.Logger)
+	a.Chain.Store(chain)
+	a.Record(chain, recorder.RecordTypeChainSwitch, map[string]string{"name": chain.Config.GetName()})
+	a.DisplayWrapper.SetState(chain.State)
+
+	a.StartRefreshing(chain)
 }
 
-func (a *App) GoRefreshConsensus() {
+func (a *App) DisplayState(chain *Chain) {
+	// Chain might have been switched while the data was fetched.
+	if chain != a.Chain.Load() {
+		return
+	}
+
+	state := chain.State
+
+	if !chain.Config.IsReplay() {
+		endpoints := chain.Aggregator.GetRPCEndpoints()
+
+		state.Lock()
+		state.SetRPCEndpoints(endpoints)
+		state.Unlock()
+
+		chain.Alerter.Check(state)
+	}
+
+	a.DisplayWrapper.SetState(state)
+}
+
+// Record records the chain's response, unless the chain was switched while it was fetched.
+func (a *App) Record(chain *Chain, recordType recorder.RecordType, data interface{}) {
+	if a.Recorder != nil && chain == a.Chain.Load() {
+		a.Recorder.Record(recordType, data)
+	}
+}
+
+func (a *App) GoRefreshConsensus(chain *Chain) {
 	defer a.HandlePanic()
 
-	a.RefreshConsensus()
+	a.RefreshConsensus(chain)
 
-	ticker := time.NewTicker(a.Config.RefreshRate)
-	done := make(chan bool)
+	ticker := time.NewTicker(chain.Config.RefreshRate)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-chain.Done:
 			return
+		case <-chain.RefreshConsensusChannel:
+			a.RefreshConsensus(chain)
 		case <-ticker.C:
-			a.RefreshConsensus()
+			// Consensus is updated via websocket events, polling is only a fallback.
+			if chain.Aggregator.IsWebsocketConnected() {
+				continue
+			}
+
+			a.RefreshConsensus(chain)
+		}
+	}
+}
+
+func (a *App) GoListenForEvents(chain *Chain) {
+	defer a.HandlePanic()
+
+	if chain.Config.DisableWebsocket {
+		return
+	}
+
+	events := chain.Aggregator.SubscribeToEvents(chain.Done)
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case event := <-events:
+			a.HandleEvent(chain, event)
+		}
+	}
+}
+
+func (a *App) HandleEvent(chain *Chain, event types.TendermintEventData) {
+	if a.IsPaused {
+		return
+	}
+
+	state := chain.State
+	a.Record(chain, recorder.RecordTypeEvent, event)
+
+	state.Lock()
+	updated, outdated := a.ApplyEvent(state, event)
+	state.Unlock()
+
+	if updated {
+		a.DisplayState(chain)
+	} else if outdated {
+		a.RequestRefresh(chain.RefreshConsensusChannel)
+	}
+
+	if outdated || event.Type == types.EventTypeCompleteProposal {
+		a.RequestRefresh(chain.RefreshDumpConsensusStateChannel)
+	}
+}
+
+// RequestRefresh asks a refresh goroutine to refetch its data, so the events listener isn't
+// blocked while it's fetched. If a refresh is already requested, it's not requested again,
+// as it would fetch the same data.
+func (a *App) RequestRefresh(channel chan bool) {
+	select {
+	case channel <- true:
+	default:
+	}
+}
+
+// ApplyEvent applies a websocket event to the state, returning whether the state was updated
+// and whether the state is outdated and needs to be refetched (e.g. on a new height or round).
+// The caller should hold the state's lock.
+func (a *App) ApplyEvent(state *types.State, event types.TendermintEventData) (bool, bool) {
+	switch event.Type {
+	case types.EventTypeVote:
+		var eventVote types.TendermintEventVote
+		if err := json.Unmarshal(event.Value, &eventVote); err != nil {
+			a.Logger.Error().Err(err).Msg("Error unmarshalling vote event")
+			return false, false
+		}
+
+		if state.AddVote(eventVote.Vote) {
+			return true, false
+		}
+
+		// Late votes from previous heights are ignored, votes from unknown rounds
+		// or heights mean our state is outdated.
+		height, err := strconv.ParseInt(eventVote.Vote.Height, 10, 64)
+		return false, err == nil && height >= state.Height
+	case types.EventTypeRoundState, types.EventTypeCompleteProposal:
+		var roundState types.TendermintEventRoundState
+		if err := json.Unmarshal(event.Value, &roundState); err != nil {
+			a.Logger.Error().Err(err).Msg("Error unmarshalling round state event")
+			return false, false
 		}
+
+		height, err := strconv.ParseInt(roundState.Height, 10, 64)
+		if err != nil {
+			a.Logger.Error().Err(err).Msg("Error parsing round state event height")
+			return false, false
+		}
+
+		step, err := types.RoundStepFromString(roundState.Step)
+		if err != nil {
+			a.Logger.Error().Err(err).Msg("Error parsing round state event step")
+			return false, false
+		}
+
+		if state.SetRoundStep(height, roundState.Round, step) {
+			return true, false
+		}
+
+		// New height or round, need to refetch votes for it.
+		return false, true
+	case types.EventTypeNewBlock:
+		return false, true
+	default:
+		a.Logger.Debug().Str("type", event.Type).Msg("Got unsupported event, skipping")
+		return false, false
 	}
 }
 
-func (a *App) RefreshConsensus() {
+func (a *App) RefreshConsensus(chain *Chain) {
 	if a.IsPaused {
 		return
 	}
 
-	consensus, validators, err := a.Aggregator.GetData()
-	a.State.SetConsensusStateError(err)
+	state, aggregator := chain.State, chain.Aggregator
+
+	consensus, validators, err := aggregator.GetData()
 	if err != nil {
 		a.Logger.Error().Err(err).Msg("Error getting consensus data")
-		a.DisplayWrapper.SetState(a.State)
+
+		state.Lock()
+		state.SetConsensusStateError(err)
+		state.Unlock()
+
+		a.DisplayState(chain)
 		return
 	}
 
-	err = a.State.SetTendermintResponse(consensus, validators)
-	a.State.SetConsensusStateError(err)
+	a.Record(chain, recorder.RecordTypeValidators, validators)
+	a.Record(chain, recorder.RecordTypeConsensusState, consensus)
+
+	state.Lock()
+	err = state.SetTendermintResponse(consensus, validators)
+	state.SetConsensusStateError(err)
+	state.Unlock()
+
 	if err != nil {
 		a.Logger.Error().Err(err).Msg("Error converting data")
-		a.DisplayWrapper.SetState(a.State)
-		return
 	}
 
-	a.State.SetConsensusStateError(err)
-	a.DisplayWrapper.SetState(a.State)
+	a.DisplayState(chain)
 }
 
-func (a *App) GoRefreshValidators() {
+func (a *App) GoRefreshValidators(chain *Chain) {
 	defer a.HandlePanic()
 
-	a.RefreshValidators()
+	a.RefreshValidators(chain)
 
-	ticker := time.NewTicker(a.Config.ValidatorsRefreshRate)
-	done := make(chan bool)
+	ticker := time.NewTicker(chain.Config.ValidatorsRefreshRate)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-chain.Done:
 			return
 		case <-ticker.C:
-			a.RefreshValidators()
+			a.RefreshValidators(chain)
 		}
 	}
 }
 
-func (a *App) RefreshValidators() {
+func (a *App) RefreshValidators(chain *Chain) {
 	if a.IsPaused {
 		return
 	}
 
-	chainValidators, err := a.Aggregator.GetChainValidators()
+	state, aggregator := chain.State, chain.Aggregator
+
+	chainValidators, err := aggregator.GetChainValidators()
 	if err != nil {
-		a.DisplayWrapper.SetState(a.State)
+		a.DisplayState(chain)
 		a.Logger.Error().Err(err).Msg("Error getting chain validators")
 		return
 	}
 
-	a.State.SetChainValidators(chainValidators)
-	a.DisplayWrapper.SetState(a.State)
+	a.Record(chain, recorder.RecordTypeChainValidators, chainValidators)
+
+	state.Lock()
+	state.SetChainValidators(chainValidators)
+	state.Unlock()
+
+	a.DisplayState(chain)
 }
 
-func (a *App) GoRefreshChainInfo() {
+func (a *App) GoRefreshChainInfo(chain *Chain) {
 	defer a.HandlePanic()
 
-	a.RefreshChainInfo()
+	a.RefreshChainInfo(chain)
 
-	ticker := time.NewTicker(a.Config.ChainInfoRefreshRate)
-	done := make(chan bool)
+	ticker := time.NewTicker(chain.Config.ChainInfoRefreshRate)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-chain.Done:
 			return
 		case <-ticker.C:
-			a.RefreshChainInfo()
+			a.RefreshChainInfo(chain)
 		}
 	}
 }
 
-func (a *App) RefreshChainInfo() {
+func (a *App) RefreshChainInfo(chain *Chain) {
 	if a.IsPaused {
 		return
 	}
 
-	chainInfo, err := a.Aggregator.GetChainInfo()
+	state, aggregator := chain.State, chain.Aggregator
+
+	chainInfo, err := aggregator.GetChainInfo()
 	if err != nil {
 		a.Logger.Error().Err(err).Msg("Error getting chain validators")
-		a.State.SetStatusError(err)
-		a.DisplayWrapper.SetState(a.State)
+
+		state.Lock()
+		state.SetStatusError(err)
+		state.Unlock()
+
+		a.DisplayState(chain)
+		return
+	}
+
+	a.Record(chain, recorder.RecordTypeStatus, chainInfo)
+
+	state.Lock()
+	state.SetNodeStatus(&chainInfo.Result)
+	state.SetStatusError(err)
+	state.Unlock()
+
+	a.DisplayState(chain)
+}
+
+func (a *App) GoRefreshNodeHealth(chain *Chain) {
+	defer a.HandlePanic()
+
+	a.RefreshNodeHealth(chain)
+
+	ticker := time.NewTicker(chain.Config.NodeHealthRefreshRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case <-ticker.C:
+			a.RefreshNodeHealth(chain)
+		}
+	}
+}
+
+// RefreshNodeHealth fetches the node's peers and mempool, and its sync info from the node status,
+// as the chain info is refreshed way less often than the node's latest block changes.
+func (a *App) RefreshNodeHealth(chain *Chain) {
+	if a.IsPaused {
 		return
 	}
 
-	a.State.SetNodeStatus(&chainInfo.Result)
-	a.State.SetStatusError(err)
-	a.DisplayWrapper.SetState(a.State)
+	state, aggregator := chain.State, chain.Aggregator
+
+	// Only the sync info is taken from the status, the rest of it is the chain info,
+	// which is refreshed on its own rate.
+	status, statusErr := aggregator.GetChainInfo()
+	if statusErr != nil {
+		a.Logger.Error().Err(statusErr).Msg("Error getting node sync info")
+	} else {
+		a.Record(chain, recorder.RecordTypeSyncInfo, status.Result.SyncInfo)
+	}
+
+	netInfo, netInfoErr := aggregator.GetNetInfo()
+	if netInfoErr != nil {
+		a.Logger.Error().Err(netInfoErr).Msg("Error getting net info")
+	} else {
+		a.Record(chain, recorder.RecordTypeNetInfo, netInfo)
+	}
+
+	mempool, mempoolErr := aggregator.GetMempool()
+	if mempoolErr != nil {
+		a.Logger.Error().Err(mempoolErr).Msg("Error getting mempool")
+	} else {
+		a.Record(chain, recorder.RecordTypeMempool, mempool)
+	}
+
+	state.Lock()
+	state.SetNodeSyncInfoError(statusErr)
+	if statusErr == nil {
+		state.SetNodeSyncInfo(&status.Result.SyncInfo)
+	}
+
+	state.SetNetInfoError(netInfoErr)
+	if netInfoErr == nil {
+		state.SetNetInfo(netInfo)
+	}
+
+	state.SetMempoolError(mempoolErr)
+	if mempoolErr == nil {
+		state.SetMempool(mempool)
+	}
+	state.Unlock()
+
+	a.DisplayState(chain)
 }
 
-func (a *App) GoRefreshUpgrade() {
+func (a *App) GoRefreshUpgrade(chain *Chain) {
 	defer a.HandlePanic()
 
-	a.RefreshUpgrade()
+	a.RefreshUpgrade(chain)
 
-	ticker := time.NewTicker(a.Config.UpgradeRefreshRate)
-	done := make(chan bool)
+	ticker := time.NewTicker(chain.Config.UpgradeRefreshRate)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-chain.Done:
 			return
 		case <-ticker.C:
-			a.RefreshUpgrade()
+			a.RefreshUpgrade(chain)
 		}
 	}
 }
 
-func (a *App) RefreshUpgrade() {
+func (a *App) RefreshUpgrade(chain *Chain) {
 	if a.IsPaused {
 		return
 	}
 
-	if a.Config.HaltHeight > 0 {
+	state, aggregator := chain.State, chain.Aggregator
+
+	if chain.Config.HaltHeight > 0 {
 		upgrade := &types.Upgrade{
 			Name:   "halt-height upgrade",
-			Height: a.Config.HaltHeight,
+			Height: chain.Config.HaltHeight,
 		}
 
-		a.State.SetUpgrade(upgrade)
-		a.DisplayWrapper.SetState(a.State)
+		state.Lock()
+		state.SetUpgrade(upgrade)
+		state.Unlock()
+
+		a.DisplayState(chain)
 		return
 	}
 
-	upgrade, err := a.Aggregator.GetUpgrade()
+	upgrade, err := aggregator.GetUpgrade()
 	if err != nil {
 		a.Logger.Error().Err(err).Msg("Error getting upgrade")
-		a.State.SetUpgradePlanError(err)
-		a.DisplayWrapper.SetState(a.State)
+
+		state.Lock()
+		state.SetUpgradePlanError(err)
+		state.Unlock()
+
+		a.DisplayState(chain)
 		return
 	}
 
-	a.State.SetUpgrade(upgrade)
-	a.State.SetUpgradePlanError(err)
-	a.DisplayWrapper.SetState(a.State)
+	state.Lock()
+	state.SetUpgrade(upgrade)
+	state.SetUpgradePlanError(err)
+	state.Unlock()
+
+	a.DisplayState(chain)
 }
 
-func (a *App) GoRefreshBlockTime() {
+func (a *App) GoRefreshBlockTime(chain *Chain) {
 	defer a.HandlePanic()
 
-	a.RefreshBlockTime()
+	a.RefreshBlockTime(chain)
 
-	ticker := time.NewTicker(a.Config.BlockTimeRefreshRate)
-	done := make(chan bool)
+	ticker := time.NewTicker(chain.Config.BlockTimeRefreshRate)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case <-done:
+		case <-chain.Done:
 			return
 		case <-ticker.C:
-			a.RefreshBlockTime()
+			a.RefreshBlockTime(chain)
 		}
 	}
 }
 
-func (a *App) RefreshBlockTime() {
+func (a *App) RefreshBlockTime(chain *Chain) {
 	if a.IsPaused {
 		return
 	}
 
-	blockTime, err := a.Aggregator.GetBlockTime()
+	state, aggregator := chain.State, chain.Aggregator
+
+	blockTime, err := aggregator.GetBlockTime()
 	if err != nil {
 		a.Logger.Error().Err(err).Msg("Error getting block time")
 		return
 	}
 
-	a.State.SetBlockTime(blockTime)
-	a.DisplayWrapper.SetState(a.State)
+	state.Lock()
+	state.SetBlockTime(blockTime)
+	state.Unlock()
+
+	a.DisplayState(chain)
+}
+
+func (a *App) GoRefreshBlocksHistory(chain *Chain) {
+	defer a.HandlePanic()
+
+	if chain.Config.BlocksHistorySize == 0 {
+		return
+	}
+
+	a.RefreshBlocksHistory(chain)
+
+	ticker := time.NewTicker(chain.Config.RefreshRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case <-ticker.C:
+			a.RefreshBlocksHistory(chain)
+		}
+	}
+}
+
+func (a *App) RefreshBlocksHistory(chain *Chain) {
+	if a.IsPaused {
+		return
+	}
+
+	state, aggregator, config := chain.State, chain.Aggregator, chain.Config
+
+	state.RLock()
+	history := state.BlocksHistory
+	state.RUnlock()
+
+	if history == nil {
+		history = types.NewBlocksHistory(int(config.BlocksHistorySize))
+	}
+
+	blocks, err := aggregator.GetBlocksSignatures(history.GetLatestHeight(), int64(config.BlocksHistorySize))
+	if err != nil {
+		a.Logger.Error().Err(err).Msg("Error getting blocks signatures")
+		return
+	}
+
+	newHistory := history.WithBlocks(blocks)
+	if newHistory.GetLatestHeight() == history.GetLatestHeight() {
+		return
+	}
+
+	// Only the new blocks are recorded, the replay merges them the same way.
+	a.Record(chain, recorder.RecordTypeBlocksHistory, types.BlocksHistory{Size: newHistory.Size, Blocks: blocks})
+
+	state.Lock()
+	state.SetBlocksHistory(newHistory)
+	state.Unlock()
+
+	a.DisplayState(chain)
+}
+
+func (a *App) GoRefreshProposersSchedule(chain *Chain) {
+	defer a.HandlePanic()
+
+	if chain.Config.ProposersScheduleSize == 0 {
+		return
+	}
+
+	a.RefreshProposersSchedule(chain)
+
+	ticker := time.NewTicker(chain.Config.RefreshRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case <-ticker.C:
+			a.RefreshProposersSchedule(chain)
+		}
+	}
+}
+
+func (a *App) RefreshProposersSchedule(chain *Chain) {
+	if a.IsPaused {
+		return
+	}
+
+	state, aggregator, config := chain.State, chain.Aggregator, chain.Config
+	state.RLock()
+	height, round := state.Height, state.Round
+	proposerPriorities, proposerPrioritiesHeight := state.ProposerPriorities, state.ProposerPrioritiesHeight
+	state.RUnlock()
+
+	if height == 0 {
+		return
+	}
+
+	// Proposer priorities only change once per height, so they are only refetched
+	// when the height changes, while the schedule is recalculated on every round.
+	if proposerPrioritiesHeight != height {
+		validators, err := aggregator.GetValidatorsAtHeight(height)
+		if err != nil {
+			a.Logger.Error().Err(err).Msg("Error getting proposer priorities")
+			return
+		}
+
+		proposerPriorities = validators
+
+		state.Lock()
+		state.SetProposerPriorities(height, validators)
+		state.Unlock()
+	}
+
+	schedule, err := types.GetProposersSchedule(
+		proposerPriorities,
+		height,
+		round,
+		ProposersScheduleRoundsCount,
+		int(config.ProposersScheduleSize),
+	)
+	if err != nil {
+		a.Logger.Error().Err(err).Msg("Error calculating proposers schedule")
+		return
+	}
+
+	state.Lock()
+	state.SetProposersSchedule(schedule)
+	state.Unlock()
+
+	a.DisplayState(chain)
+}
+
+func (a *App) GoRefreshDumpConsensusState(chain *Chain) {
+	defer a.HandlePanic()
+
+	a.RefreshDumpConsensusState(chain)
+
+	ticker := time.NewTicker(chain.Config.RoundStateRefreshRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case <-chain.RefreshDumpConsensusStateChannel:
+			a.RefreshDumpConsensusState(chain)
+		case <-ticker.C:
+			a.RefreshDumpConsensusState(chain)
+		}
+	}
+}
+
+func (a *App) RefreshDumpConsensusState(chain *Chain) {
+	if a.IsPaused {
+		return
+	}
+
+	state, aggregator := chain.State, chain.Aggregator
+
+	dumpConsensusState, err := aggregator.GetDumpConsensusState()
+	if err != nil {
+		a.Logger.Error().Err(err).Msg("Error getting dump consensus state")
+
+		state.Lock()
+		state.SetDumpConsensusStateError(err)
+		state.Unlock()
+
+		a.DisplayState(chain)
+		return
+	}
+
+	a.Record(chain, recorder.RecordTypeDumpConsensusState, dumpConsensusState)
+
+	state.Lock()
+	state.SetDumpConsensusStateError(err)
+	state.SetDumpConsensusState(dumpConsensusState.RoundState)
+	state.SetPeers(dumpConsensusState.Peers)
+	state.Unlock()
+
+	a.DisplayState(chain)
+}
+
+func (a *App) GoCheckHealth(chain *Chain) {
+	defer a.HandlePanic()
+
+	a.CheckHealth(chain)
+
+	ticker := time.NewTicker(chain.Config.HealthCheckRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case <-ticker.C:
+			a.CheckHealth(chain)
+		}
+	}
+}
+
+func (a *App) CheckHealth(chain *Chain) {
+	if a.IsPaused {
+		return
+	}
+
+	chain.Aggregator.CheckHealth()
+	a.DisplayState(chain)
 }
 
 func (a *App) DisplayLogs() {
@@ -265,9 +827,16 @@ func (a *App) ListenForPause() {
 	}
 }
 
+func (a *App) ListenForChainSwitch() {
+	for {
+		index := <-a.SwitchChainChannel
+		a.SwitchChain(index)
+	}
+}
+
 func (a *App) HandlePanic() {
 	if r := recover(); r != nil {
-		a.DisplayWrapper.App.Stop()
+		a.DisplayWrapper.Stop()
 		panic(r)
 	}
 }
@@ -0,0 +1,178 @@
+package pkg
+
+import (
+	"encoding/json"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+const testConsensusState = `{
+  "result": {
+    "round_state": {
+      "height/round/step": "100/0/4",
+      "start_time": "2024-01-01T00:00:00Z",
+      "height_vote_set": [
+        {
+          "round": 0,
+          "prevotes": [
+            "Vote{0:A1B2C3D4E5F6 100/00/SIGNED_MSG_TYPE_PREVOTE(Prevote) ABCDEF123456 8E2B2C35C8C9 @ 2024-01-01T00:00:01Z}",
+            "nil-Vote",
+            "nil-Vote"
+          ],
+          "precommits": ["nil-Vote", "nil-Vote", "nil-Vote"],
+          "prevotes_bit_array": "",
+          "precommits_bit_array": ""
+        }
+      ],
+      "proposer": {"address": "A1B2C3D4E5F60718293A4B5C6D7E8F9012345678", "index": 0}
+    }
+  }
+}`
+
+var testValidators = []types.TendermintValidator{
+	{Address: "A1B2C3D4E5F60718293A4B5C6D7E8F9012345678", VotingPower: "100"},
+	{Address: "B1B2C3D4E5F60718293A4B5C6D7E8F9012345678", VotingPower: "50"},
+	{Address: "C1B2C3D4E5F60718293A4B5C6D7E8F9012345678", VotingPower: "30"},
+}
+
+func newTestState(t *testing.T) *types.State {
+	t.Helper()
+
+	var consensus types.ConsensusStateResponse
+	if err := json.Unmarshal([]byte(testConsensusState), &consensus); err != nil {
+		t.Fatalf("could not unmarshal consensus state: %s", err)
+	}
+
+	state := types.NewState(nil)
+	if err := state.SetTendermintResponse(&consensus, testValidators); err != nil {
+		t.Fatalf("could not set consensus state: %s", err)
+	}
+
+	return state
+}
+
+func TestApplyEvent(t *testing.T) {
+	t.Parallel()
+
+	app := &App{Logger: zerolog.Nop()}
+	state := newTestState(t)
+
+	// Frames are replayed in order, as they'd come from the websocket.
+	frames := []struct {
+		name     string
+		event    string
+		updated  bool
+		outdated bool
+	}{
+		{
+			name:    "prevote for a block",
+			event:   `{"type":"tendermint/event/Vote","value":{"Vote":{"type":1,"height":"100","round":0,"block_id":{"hash":"ABCDEF1234567890"},"timestamp":"2024-01-01T00:00:02Z","validator_address":"B1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":1,"signature":"AAAA"}}}`,
+			updated: true,
+		},
+		{
+			name:    "prevote for nil",
+			event:   `{"type":"tendermint/event/Vote","value":{"Vote":{"type":1,"height":"100","round":0,"block_id":{"hash":""},"timestamp":"2024-01-01T00:00:02Z","validator_address":"C1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":2,"signature":"AAAA"}}}`,
+			updated: true,
+		},
+		{
+			name:    "step change in the same round",
+			event:   `{"type":"tendermint/event/RoundState","value":{"height":"100","round":0,"step":"RoundStepPrecommit"}}`,
+			updated: true,
+		},
+		{
+			name:    "precommit",
+			event:   `{"type":"tendermint/event/Vote","value":{"Vote":{"type":2,"height":"100","round":0,"block_id":{"hash":"ABCDEF1234567890"},"timestamp":"2024-01-01T00:00:03Z","validator_address":"A1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":0,"signature":"AAAA"}}}`,
+			updated: true,
+		},
+		{
+			name:  "late vote from the previous height",
+			event: `{"type":"tendermint/event/Vote","value":{"Vote":{"type":2,"height":"99","round":0,"block_id":{"hash":"ABCDEF1234567890"},"timestamp":"2024-01-01T00:00:03Z","validator_address":"A1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":0,"signature":"AAAA"}}}`,
+		},
+		{
+			name:     "vote with mismatching validator address",
+			event:    `{"type":"tendermint/event/Vote","value":{"Vote":{"type":2,"height":"100","round":0,"block_id":{"hash":"ABCDEF1234567890"},"timestamp":"2024-01-01T00:00:03Z","validator_address":"D1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":1,"signature":"AAAA"}}}`,
+			outdated: true,
+		},
+		{
+			name:     "vote from an unknown round",
+			event:    `{"type":"tendermint/event/Vote","value":{"Vote":{"type":1,"height":"100","round":1,"block_id":{"hash":""},"timestamp":"2024-01-01T00:00:04Z","validator_address":"A1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":0,"signature":"AAAA"}}}`,
+			outdated: true,
+		},
+		{
+			name:     "new round",
+			event:    `{"type":"tendermint/event/RoundState","value":{"height":"100","round":1,"step":"RoundStepNewRound"}}`,
+			outdated: true,
+		},
+		{
+			name:  "unknown step",
+			event: `{"type":"tendermint/event/RoundState","value":{"height":"100","round":0,"step":"RoundStepUnknown"}}`,
+		},
+		{
+			name:     "new block",
+			event:    `{"type":"tendermint/event/NewBlock","value":{}}`,
+			outdated: true,
+		},
+		{
+			name:  "unsupported event",
+			event: `{"type":"tendermint/event/Tx","value":{}}`,
+		},
+	}
+
+	for _, frame := range frames {
+		var event types.TendermintEventData
+		if err := json.Unmarshal([]byte(frame.event), &event); err != nil {
+			t.Fatalf("%s: could not unmarshal event: %s", frame.name, err)
+		}
+
+		updated, outdated := app.ApplyEvent(state, event)
+		if updated != frame.updated || outdated != frame.outdated {
+			t.Errorf(
+				"%s: expected updated=%t outdated=%t, got updated=%t outdated=%t",
+				frame.name,
+				frame.updated,
+				frame.outdated,
+				updated,
+				outdated,
+			)
+		}
+	}
+
+	if state.Height != 100 || state.Round != 0 || state.Step != types.RoundStepPrecommit {
+		t.Errorf("expected 100/0/%d, got %d/%d/%d", types.RoundStepPrecommit, state.Height, state.Round, state.Step)
+	}
+
+	expectedVotes := []struct {
+		prevote   types.Vote
+		precommit types.Vote
+	}{
+		{prevote: types.Voted, precommit: types.Voted},
+		{prevote: types.Voted, precommit: types.VotedNil},
+		{prevote: types.VotedZero, precommit: types.VotedNil},
+	}
+
+	validators := *state.Validators
+	if len(validators) != len(expectedVotes) {
+		t.Fatalf("expected %d validators, got %d", len(expectedVotes), len(validators))
+	}
+
+	for index, expected := range expectedVotes {
+		roundVote := validators[index].RoundVote
+		if roundVote.Prevote != expected.prevote || roundVote.Precommit != expected.precommit {
+			t.Errorf(
+				"validator #%d: expected prevote %v and precommit %v, got prevote %v and precommit %v",
+				index,
+				expected.prevote,
+				expected.precommit,
+				roundVote.Prevote,
+				roundVote.Precommit,
+			)
+		}
+
+		allRoundsVote := state.ValidatorsWithAllRoundsVotes.RoundsVotes[0][index]
+		if !allRoundsVote.Equals(roundVote) {
+			t.Errorf("validator #%d: last round and all rounds votes differ", index)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package pkg
+
+import (
+	"main/pkg/aggregator"
+	"main/pkg/alerter"
+	configPkg "main/pkg/config"
+	"main/pkg/display"
+	"main/pkg/types"
+
+	"github.com/rs/zerolog"
+)
+
+// Chain is what the refresh goroutines of the displayed chain work with. Switching chains
+// creates a new one instead of modifying the current one, so the previous chain's goroutines,
+// which might still be finishing their requests, never see the new chain's data.
+type Chain struct {
+	Config     *configPkg.Config
+	Aggregator *aggregator.Aggregator
+	Alerter    *alerter.Alerter
+	State      *types.State
+	Done       chan bool
+
+	// RefreshConsensusChannel asks the consensus refresh goroutine to refetch the consensus
+	// state out of order, e.g. when a websocket event shows the state is outdated.
+	RefreshConsensusChannel chan bool
+	// RefreshDumpConsensusStateChannel does the same for the round state, which is polled rarely
+	// and refetched when the proposal or the round changes.
+	RefreshDumpConsensusStateChannel chan bool
+}
+
+func NewChain(config *configPkg.Config, displayWrapper display.Display, logger zerolog.Logger) *Chain {
+	return &Chain{
+		Config:     config,
+		Aggregator: aggregator.NewAggregator(config, logger),
+		Alerter:    alerter.NewAlerter(config, displayWrapper, logger),
+		State:      types.NewState(config.MyValidators),
+		Done:       make(chan bool),
+
+		RefreshConsensusChannel:          make(chan bool, 1),
+		RefreshDumpConsensusStateChannel: make(chan bool, 1),
+	}
+}
+
+// Stop stops the chain's refresh goroutines and closes its connections.
+func (c *Chain) Stop() {
+	close(c.Done)
+
+	if err := c.Aggregator.Close(); err != nil {
+		c.Aggregator.Logger.Warn().Err(err).Msg("Could not close connections")
+	}
+}
+
+// WithState returns the same chain with another state.
+func (c *Chain) WithState(state *types.State) *Chain {
+	chain := *c
+	chain.State = state
+	return &chain
+}
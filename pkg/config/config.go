@@ -3,26 +3,53 @@ package config
 import (
 	"errors"
 	"fmt"
+	"main/pkg/types"
 	"time"
 )
 
+const DefaultRPCHost = "http://localhost:26657"
+
 type InputConfig struct {
-	RPCHost               string
-	ProviderRPCHost       string
+	ConfigPath            string
+	Profile               string
+	RPCHosts              []string
+	ProviderRPCHosts      []string
 	ConsumerID            string
 	RefreshRate           time.Duration
 	ValidatorsRefreshRate time.Duration
 	ChainInfoRefreshRate  time.Duration
 	UpgradeRefreshRate    time.Duration
 	BlockTimeRefreshRate  time.Duration
+	NodeHealthRefreshRate time.Duration
+	RoundStateRefreshRate time.Duration
+	HealthCheckRate       time.Duration
+	FailoverTimeout       time.Duration
 	ChainType             string
 	Verbose               bool
 	DisableEmojis         bool
 	DebugFile             string
 	HaltHeight            int64
 	BlocksBehind          uint64
+	BlocksHistorySize     uint64
+	ProposersScheduleSize uint64
+	MyValidators          []string
+	AlertMissedVotes      bool
+	AlertStuckHeight      time.Duration
+	AlertRound            int64
+	AlertCommand          string
+	AlertWebhook          string
+	AlertBell             bool
 	LCDHost               string
+	ProviderLCDHost       string
+	GRPCHost              string
+	ProviderGRPCHost      string
+	GRPCTLS               bool
+	ProviderGRPCTLS       bool
 	Timezone              string
+	DisableWebsocket      bool
+	ListenAddress         string
+	RecordFile            string
+	ReplayFile            string
 }
 
 type ChainType string
@@ -30,6 +57,7 @@ type ChainType string
 const (
 	ChainTypeCosmosRPC  ChainType = "cosmos-rpc"
 	ChainTypeCosmosLCD  ChainType = "cosmos-lcd"
+	ChainTypeCosmosGRPC ChainType = "cosmos-grpc"
 	ChainTypeTendermint ChainType = "tendermint"
 )
 
@@ -43,12 +71,14 @@ func ParseChainType(v string) (ChainType, error) {
 		return ChainTypeCosmosRPC, nil
 	case "cosmos-lcd":
 		return ChainTypeCosmosLCD, nil
+	case "cosmos-grpc":
+		return ChainTypeCosmosGRPC, nil
 	case "tendermint":
 		return ChainTypeTendermint, nil
 	}
 
 	return "", fmt.Errorf(
-		"expected chain-type to be one of 'cosmos-rpc', 'cosmos-lcd', 'tendermint', but got '%s'",
+		"expected chain-type to be one of 'cosmos-rpc', 'cosmos-lcd', 'cosmos-grpc', 'tendermint', but got '%s'",
 		v,
 	)
 }
@@ -59,7 +89,19 @@ func ParseAndValidateConfig(input InputConfig) (*Config, error) {
 		return nil, err
 	}
 
-	if input.ProviderRPCHost != "" && input.ConsumerID == "" {
+	if len(input.RPCHosts) == 0 {
+		return nil, errors.New("no RPC hosts specified")
+	}
+
+	for _, hosts := range [][]string{input.RPCHosts, input.ProviderRPCHosts} {
+		for _, host := range hosts {
+			if host == "" {
+				return nil, errors.New("RPC host cannot be empty")
+			}
+		}
+	}
+
+	if len(input.ProviderRPCHosts) > 0 && input.ConsumerID == "" {
 		return nil, errors.New("chain is consumer, but consumer-id is not set")
 	}
 
@@ -67,10 +109,40 @@ func ParseAndValidateConfig(input InputConfig) (*Config, error) {
 		return nil, errors.New("chain-type is 'cosmos-lcd', but lcd-host is not set")
 	}
 
+	if chainType == ChainTypeCosmosLCD && len(input.ProviderRPCHosts) > 0 && input.ProviderLCDHost == "" {
+		return nil, errors.New("chain-type is 'cosmos-lcd' and chain is consumer, but provider-lcd-host is not set")
+	}
+
+	if chainType == ChainTypeCosmosGRPC && input.GRPCHost == "" {
+		return nil, errors.New("chain-type is 'cosmos-grpc', but grpc-host is not set")
+	}
+
+	if chainType == ChainTypeCosmosGRPC && len(input.ProviderRPCHosts) > 0 && input.ProviderGRPCHost == "" {
+		return nil, errors.New("chain-type is 'cosmos-grpc' and chain is consumer, but provider-grpc-host is not set")
+	}
+
 	if input.BlocksBehind <= 0 {
 		return nil, errors.New("cannot run with a negative blocks-behind")
 	}
 
+	if input.RecordFile != "" && input.ReplayFile != "" {
+		return nil, errors.New("cannot record while replaying")
+	}
+
+	if input.AlertRound < 0 {
+		return nil, errors.New("cannot run with a negative alert-round")
+	}
+
+	myValidators := make(types.MyValidators, len(input.MyValidators))
+	for index, value := range input.MyValidators {
+		myValidator, err := ParseMyValidator(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid my-validator: %w", err)
+		}
+
+		myValidators[index] = myValidator
+	}
+
 	timezone := time.Local //nolint:gosmopolitan // local timezone is expected here
 
 	if input.Timezone != "" {
@@ -83,54 +155,115 @@ func ParseAndValidateConfig(input InputConfig) (*Config, error) {
 	}
 
 	config := &Config{
-		RPCHost:               input.RPCHost,
-		ProviderRPCHost:       input.ProviderRPCHost,
+		RPCHosts:              input.RPCHosts,
+		ProviderRPCHosts:      input.ProviderRPCHosts,
 		ConsumerID:            input.ConsumerID,
 		RefreshRate:           input.RefreshRate,
 		ValidatorsRefreshRate: input.ValidatorsRefreshRate,
 		ChainInfoRefreshRate:  input.ChainInfoRefreshRate,
 		UpgradeRefreshRate:    input.UpgradeRefreshRate,
 		BlockTimeRefreshRate:  input.BlockTimeRefreshRate,
+		NodeHealthRefreshRate: input.NodeHealthRefreshRate,
+		RoundStateRefreshRate: input.RoundStateRefreshRate,
+		HealthCheckRate:       input.HealthCheckRate,
+		FailoverTimeout:       input.FailoverTimeout,
 		ChainType:             chainType,
 		Verbose:               input.Verbose,
 		DisableEmojis:         input.DisableEmojis,
 		DebugFile:             input.DebugFile,
 		HaltHeight:            input.HaltHeight,
 		BlocksBehind:          input.BlocksBehind,
+		BlocksHistorySize:     input.BlocksHistorySize,
+		ProposersScheduleSize: input.ProposersScheduleSize,
+		MyValidators:          myValidators,
+		AlertMissedVotes:      input.AlertMissedVotes,
+		AlertStuckHeight:      input.AlertStuckHeight,
+		AlertRound:            input.AlertRound,
+		AlertCommand:          input.AlertCommand,
+		AlertWebhook:          input.AlertWebhook,
+		AlertBell:             input.AlertBell,
 		LCDHost:               input.LCDHost,
+		ProviderLCDHost:       input.ProviderLCDHost,
+		GRPCHost:              input.GRPCHost,
+		ProviderGRPCHost:      input.ProviderGRPCHost,
+		GRPCTLS:               input.GRPCTLS,
+		ProviderGRPCTLS:       input.ProviderGRPCTLS,
 		Timezone:              timezone,
+		DisableWebsocket:      input.DisableWebsocket,
+		ListenAddress:         input.ListenAddress,
+		RecordFile:            input.RecordFile,
+		ReplayFile:            input.ReplayFile,
 	}
 
 	return config, nil
 }
 
 type Config struct {
-	RPCHost               string
-	ProviderRPCHost       string
+	Name                  string
+	RPCHosts              []string
+	ProviderRPCHosts      []string
 	ConsumerID            string
 	RefreshRate           time.Duration
 	ValidatorsRefreshRate time.Duration
 	ChainInfoRefreshRate  time.Duration
 	UpgradeRefreshRate    time.Duration
 	BlockTimeRefreshRate  time.Duration
+	NodeHealthRefreshRate time.Duration
+	RoundStateRefreshRate time.Duration
+	HealthCheckRate       time.Duration
+	FailoverTimeout       time.Duration
 	ChainType             ChainType
 	Verbose               bool
 	DisableEmojis         bool
 	DebugFile             string
 	HaltHeight            int64
 	BlocksBehind          uint64
+	BlocksHistorySize     uint64
+	ProposersScheduleSize uint64
+	MyValidators          types.MyValidators
+	AlertMissedVotes      bool
+	AlertStuckHeight      time.Duration
+	AlertRound            int64
+	AlertCommand          string
+	AlertWebhook          string
+	AlertBell             bool
 	LCDHost               string
+	ProviderLCDHost       string
+	GRPCHost              string
+	ProviderGRPCHost      string
+	GRPCTLS               bool
+	ProviderGRPCTLS       bool
 	Timezone              *time.Location
+	DisableWebsocket      bool
+	ListenAddress         string
+	RecordFile            string
+	ReplayFile            string
+}
+
+func (c Config) GetProviderOrConsumerHosts() []string {
+	if len(c.ProviderRPCHosts) > 0 {
+		return c.ProviderRPCHosts
+	}
+
+	return c.RPCHosts
 }
 
-func (c Config) GetProviderOrConsumerHost() string {
-	if c.ProviderRPCHost != "" {
-		return c.ProviderRPCHost
+func (c Config) GetName() string {
+	if c.Name != "" {
+		return c.Name
 	}
 
-	return c.RPCHost
+	return c.RPCHosts[0]
 }
 
 func (c Config) IsConsumer() bool {
-	return c.ProviderRPCHost != ""
+	return len(c.ProviderRPCHosts) > 0
+}
+
+func (c Config) IsExporter() bool {
+	return c.ListenAddress != ""
+}
+
+func (c Config) IsReplay() bool {
+	return c.ReplayFile != ""
 }
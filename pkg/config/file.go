@@ -0,0 +1,228 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type FileConfig struct {
+	Profiles []ProfileConfig `yaml:"profiles"`
+}
+
+type ProfileConfig struct {
+	Name                  string        `yaml:"name"`
+	RPCHosts              StringList    `yaml:"rpc-host"`
+	ProviderRPCHosts      StringList    `yaml:"provider-rpc-host"`
+	ConsumerID            string        `yaml:"consumer-id"`
+	ChainType             string        `yaml:"chain-type"`
+	LCDHost               string        `yaml:"lcd-host"`
+	ProviderLCDHost       string        `yaml:"provider-lcd-host"`
+	GRPCHost              string        `yaml:"grpc-host"`
+	ProviderGRPCHost      string        `yaml:"provider-grpc-host"`
+	GRPCTLS               bool          `yaml:"grpc-tls"`
+	ProviderGRPCTLS       bool          `yaml:"provider-grpc-tls"`
+	RefreshRate           time.Duration `yaml:"refresh-rate"`
+	ValidatorsRefreshRate time.Duration `yaml:"validators-refresh-rate"`
+	ChainInfoRefreshRate  time.Duration `yaml:"chain-info-refresh-rate"`
+	UpgradeRefreshRate    time.Duration `yaml:"upgrade-refresh-rate"`
+	BlockTimeRefreshRate  time.Duration `yaml:"block-time-refresh-rate"`
+	NodeHealthRefreshRate time.Duration `yaml:"node-health-refresh-rate"`
+	RoundStateRefreshRate time.Duration `yaml:"round-state-refresh-rate"`
+	HealthCheckRate       time.Duration `yaml:"health-check-rate"`
+	FailoverTimeout       time.Duration `yaml:"failover-timeout"`
+	DisableWebsocket      bool          `yaml:"disable-websocket"`
+	Timezone              string        `yaml:"timezone"`
+	HaltHeight            int64         `yaml:"halt-height"`
+	BlocksBehind          uint64        `yaml:"blocks-behind"`
+	BlocksHistorySize     uint64        `yaml:"blocks-history"`
+	ProposersScheduleSize uint64        `yaml:"proposers-schedule"`
+	MyValidators          StringList    `yaml:"my-validator"`
+	AlertMissedVotes      bool          `yaml:"alert-missed-votes"`
+	AlertStuckHeight      time.Duration `yaml:"alert-stuck-height"`
+	AlertRound            int64         `yaml:"alert-round"`
+	AlertCommand          string        `yaml:"alert-command"`
+	AlertWebhook          string        `yaml:"alert-webhook"`
+	AlertBell             bool          `yaml:"alert-bell"`
+}
+
+func LoadFileConfig(path string) (*FileConfig, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileConfig FileConfig
+	if err := yaml.Unmarshal(bytes, &fileConfig); err != nil {
+		return nil, err
+	}
+
+	if len(fileConfig.Profiles) == 0 {
+		return nil, errors.New("no profiles specified in config")
+	}
+
+	names := make(map[string]bool, len(fileConfig.Profiles))
+
+	for index, profile := range fileConfig.Profiles {
+		if profile.Name == "" {
+			return nil, fmt.Errorf("profile #%d has no name", index+1)
+		}
+
+		if names[profile.Name] {
+			return nil, fmt.Errorf("profile '%s' is specified more than once", profile.Name)
+		}
+
+		names[profile.Name] = true
+	}
+
+	return &fileConfig, nil
+}
+
+// ParseAndValidate merges every profile with the values passed via flags, validates all of them
+// and returns the config for the profile requested (or for the first one if none is requested)
+// along with the configs for all profiles.
+// Values explicitly set via flags take precedence over the ones from the file.
+func (f *FileConfig) ParseAndValidate(
+	input InputConfig,
+	isFlagChanged func(name string) bool,
+) (*Config, []*Config, error) {
+	var selected *Config
+
+	configs := make([]*Config, len(f.Profiles))
+
+	for index, profile := range f.Profiles {
+		config, err := ParseAndValidateConfig(profile.Merge(input, isFlagChanged))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error in profile '%s': %w", profile.Name, err)
+		}
+
+		config.Name = profile.Name
+		configs[index] = config
+
+		if selected == nil && (input.Profile == "" || input.Profile == profile.Name) {
+			selected = config
+		}
+	}
+
+	if selected == nil {
+		return nil, nil, fmt.Errorf("profile '%s' is not found in config", input.Profile)
+	}
+
+	return selected, configs, nil
+}
+
+func (p ProfileConfig) Merge(input InputConfig, isFlagChanged func(name string) bool) InputConfig {
+	mergeString := func(flag string, value string, target *string) {
+		if value != "" && !isFlagChanged(flag) {
+			*target = value
+		}
+	}
+
+	mergeDuration := func(flag string, value time.Duration, target *time.Duration) {
+		if value != 0 && !isFlagChanged(flag) {
+			*target = value
+		}
+	}
+
+	// RPC hosts can be passed either as a positional argument or as a flag,
+	// so they are only set if neither of these was passed.
+	if len(p.RPCHosts) > 0 && len(input.RPCHosts) == 0 {
+		input.RPCHosts = p.RPCHosts
+	}
+
+	if len(p.ProviderRPCHosts) > 0 && !isFlagChanged("provider-rpc-host") {
+		input.ProviderRPCHosts = p.ProviderRPCHosts
+	}
+
+	if len(p.MyValidators) > 0 && !isFlagChanged("my-validator") {
+		input.MyValidators = p.MyValidators
+	}
+
+	mergeString("consumer-id", p.ConsumerID, &input.ConsumerID)
+	mergeString("chain-type", p.ChainType, &input.ChainType)
+	mergeString("lcd-host", p.LCDHost, &input.LCDHost)
+	mergeString("provider-lcd-host", p.ProviderLCDHost, &input.ProviderLCDHost)
+	mergeString("grpc-host", p.GRPCHost, &input.GRPCHost)
+	mergeString("provider-grpc-host", p.ProviderGRPCHost, &input.ProviderGRPCHost)
+	mergeString("timezone", p.Timezone, &input.Timezone)
+	mergeString("alert-command", p.AlertCommand, &input.AlertCommand)
+	mergeString("alert-webhook", p.AlertWebhook, &input.AlertWebhook)
+
+	mergeDuration("refresh-rate", p.RefreshRate, &input.RefreshRate)
+	mergeDuration("validators-refresh-rate", p.ValidatorsRefreshRate, &input.ValidatorsRefreshRate)
+	mergeDuration("chain-info-refresh-rate", p.ChainInfoRefreshRate, &input.ChainInfoRefreshRate)
+	mergeDuration("upgrade-refresh-rate", p.UpgradeRefreshRate, &input.UpgradeRefreshRate)
+	mergeDuration("block-time-refresh-rate", p.BlockTimeRefreshRate, &input.BlockTimeRefreshRate)
+	mergeDuration("node-health-refresh-rate", p.NodeHealthRefreshRate, &input.NodeHealthRefreshRate)
+	mergeDuration("round-state-refresh-rate", p.RoundStateRefreshRate, &input.RoundStateRefreshRate)
+	mergeDuration("health-check-rate", p.HealthCheckRate, &input.HealthCheckRate)
+	mergeDuration("failover-timeout", p.FailoverTimeout, &input.FailoverTimeout)
+	mergeDuration("alert-stuck-height", p.AlertStuckHeight, &input.AlertStuckHeight)
+
+	if p.HaltHeight != 0 && !isFlagChanged("halt-height") {
+		input.HaltHeight = p.HaltHeight
+	}
+
+	if p.BlocksBehind != 0 && !isFlagChanged("blocks-behind") {
+		input.BlocksBehind = p.BlocksBehind
+	}
+
+	if p.BlocksHistorySize != 0 && !isFlagChanged("blocks-history") {
+		input.BlocksHistorySize = p.BlocksHistorySize
+	}
+
+	if p.ProposersScheduleSize != 0 && !isFlagChanged("proposers-schedule") {
+		input.ProposersScheduleSize = p.ProposersScheduleSize
+	}
+
+	if p.AlertMissedVotes && !isFlagChanged("alert-missed-votes") {
+		input.AlertMissedVotes = true
+	}
+
+	if p.AlertRound != 0 && !isFlagChanged("alert-round") {
+		input.AlertRound = p.AlertRound
+	}
+
+	if p.AlertBell && !isFlagChanged("alert-bell") {
+		input.AlertBell = true
+	}
+
+	if p.GRPCTLS && !isFlagChanged("grpc-tls") {
+		input.GRPCTLS = true
+	}
+
+	if p.ProviderGRPCTLS && !isFlagChanged("provider-grpc-tls") {
+		input.ProviderGRPCTLS = true
+	}
+
+	if p.DisableWebsocket && !isFlagChanged("disable-websocket") {
+		input.DisableWebsocket = true
+	}
+
+	if len(input.RPCHosts) == 0 {
+		input.RPCHosts = []string{DefaultRPCHost}
+	}
+
+	return input
+}
+
+// StringList allows specifying either a single value or a list of values in YAML.
+type StringList []string
+
+func (l *StringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = StringList{value.Value}
+		return nil
+	}
+
+	var values []string
+	if err := value.Decode(&values); err != nil {
+		return err
+	}
+
+	*l = values
+	return nil
+}
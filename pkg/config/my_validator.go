@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"main/pkg/types"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+type PrivValidatorKey struct {
+	Address string `json:"address"`
+}
+
+// ParseMyValidator accepts a hex consensus address, a bech32 valcons or valoper address,
+// or a path to priv_validator_key.json.
+func ParseMyValidator(value string) (types.MyValidator, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return types.MyValidator{}, fmt.Errorf("validator cannot be empty")
+	}
+
+	if info, err := os.Stat(value); err == nil && !info.IsDir() {
+		return parsePrivValidatorKey(value)
+	}
+
+	if decoded, err := hex.DecodeString(value); err == nil && len(decoded) > 0 {
+		return types.MyValidator{Address: strings.ToUpper(value)}, nil
+	}
+
+	prefix, data, err := bech32.Decode(value)
+	if err != nil {
+		return types.MyValidator{}, fmt.Errorf(
+			"expected '%s' to be a hex address, a bech32 address or a path to priv_validator_key.json",
+			value,
+		)
+	}
+
+	switch {
+	case strings.HasSuffix(prefix, "valoper"):
+		return types.MyValidator{OperatorAddress: value}, nil
+	case strings.HasSuffix(prefix, "valcons"):
+		converted, err := bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			return types.MyValidator{}, err
+		}
+
+		return types.MyValidator{Address: fmt.Sprintf("%X", converted)}, nil
+	default:
+		return types.MyValidator{}, fmt.Errorf(
+			"expected '%s' to be either a valoper or a valcons address, but got '%s' prefix",
+			value,
+			prefix,
+		)
+	}
+}
+
+func parsePrivValidatorKey(path string) (types.MyValidator, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return types.MyValidator{}, err
+	}
+
+	var key PrivValidatorKey
+	if err := json.Unmarshal(content, &key); err != nil {
+		return types.MyValidator{}, fmt.Errorf("could not parse '%s': %w", path, err)
+	}
+
+	if key.Address == "" {
+		return types.MyValidator{}, fmt.Errorf("could not parse '%s': address is not set", path)
+	}
+
+	return types.MyValidator{Address: strings.ToUpper(key.Address)}, nil
+}
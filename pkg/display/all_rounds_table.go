@@ -1,7 +1,10 @@
 package display
 
 import (
+	"fmt"
 	"main/pkg/types"
+	"slices"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -13,10 +16,12 @@ import (
 type AllRoundsTableData struct {
 	tview.TableContentReadOnly
 
-	Validators              types.ValidatorsWithInfoAndAllRoundVotes
-	CurrentValidatorAddress string
-	DisableEmojis           bool
-	Transpose               bool
+	Validators    types.ValidatorsWithInfoAndAllRoundVotes
+	MyValidators  types.MyValidators
+	DisableEmojis bool
+	Transpose     bool
+	Filter        string
+	SortOrder     types.SortOrder
 
 	cells [][]*tview.TableCell
 	mutex sync.Mutex
@@ -66,18 +71,19 @@ func (d *AllRoundsTableData) GetColumnCount() int {
 
 func (d *AllRoundsTableData) SetValidators(
 	validators types.ValidatorsWithInfoAndAllRoundVotes,
-	statusResult *types.TendermintStatusResult,
+	myValidators types.MyValidators,
 ) {
-	if d.Validators.Equals(validators) {
+	// The node's own validator might be discovered while the votes stay the same,
+	// so it's updated before checking whether anything else has changed.
+	myValidatorsChanged := !slices.Equal(d.MyValidators, myValidators)
+	d.MyValidators = myValidators
+
+	if !myValidatorsChanged && d.Validators.Equals(validators) {
 		return
 	}
 
 	d.Validators = validators
 
-	if statusResult != nil {
-		d.CurrentValidatorAddress = statusResult.ValidatorInfo.Address
-	}
-
 	d.redrawCells()
 }
 
@@ -86,13 +92,45 @@ func (d *AllRoundsTableData) SetTranspose(transpose bool) {
 	d.redrawCells()
 }
 
+func (d *AllRoundsTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *AllRoundsTableData) SetSortOrder(order types.SortOrder) {
+	d.SortOrder = order
+	d.redrawCells()
+}
+
 func (d *AllRoundsTableData) redrawCells() {
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
-	d.cells = make([][]*tview.TableCell, len(d.Validators.Validators)+1)
+	indexes := make([]int, 0)
+	for index, validator := range d.Validators.Validators {
+		if validator.Matches(d.Filter) {
+			indexes = append(indexes, index)
+		}
+	}
+
+	// Sorting by votes uses the votes from the latest round anyone has voted in.
+	latestRoundVotes := d.Validators.GetLatestRoundVotes()
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return d.SortOrder.Less(
+			d.getValidatorWithInfo(indexes[i], latestRoundVotes),
+			d.getValidatorWithInfo(indexes[j], latestRoundVotes),
+		)
+	})
+
+	// Coloring validators by the block they prevoted for, separately for each round.
+	roundsBlockHashesVotes := make([]types.BlockHashesVotes, len(d.Validators.RoundsVotes))
+	for round := range d.Validators.RoundsVotes {
+		roundsBlockHashesVotes[round] = d.Validators.GetRoundValidators(round).GetPrevotesByBlockHash()
+	}
+
+	d.cells = make([][]*tview.TableCell, len(indexes)+1)
 
-	for row := 0; row < len(d.Validators.Validators)+1; row++ {
+	for row := 0; row < len(indexes)+1; row++ {
 		d.cells[row] = make([]*tview.TableCell, len(d.Validators.RoundsVotes)+1)
 
 		for column := 0; column < len(d.Validators.RoundsVotes)+1; column++ {
@@ -104,6 +142,10 @@ func (d *AllRoundsTableData) redrawCells() {
 			// Table header.
 			if row == 0 {
 				text := "validator"
+				if d.SortOrder != types.SortByIndex {
+					text = fmt.Sprintf("validator (by %s)", d.SortOrder)
+				}
+
 				if column != 0 {
 					text = strconv.Itoa(round)
 				}
@@ -111,29 +153,34 @@ func (d *AllRoundsTableData) redrawCells() {
 				d.cells[row][column] = tview.
 					NewTableCell(text).
 					SetAlign(tview.AlignCenter).
-					SetStyle(tcell.StyleDefault.Bold(true))
+					SetStyle(tcell.StyleDefault.Bold(true)).
+					SetSelectable(false)
 				continue
 			}
 
 			// First column is always validators list.
 			if column == 0 {
-				text := d.Validators.Validators[row-1].Serialize()
-				cell := tview.NewTableCell(text)
+				text := d.Validators.Validators[indexes[row-1]].Serialize()
+				cell := tview.NewTableCell(text).SetReference(indexes[row-1])
 				d.cells[row][column] = cell
 				continue
 			}
 
 			roundVotes := d.Validators.RoundsVotes[round]
-			roundVote := roundVotes[row-1]
+			roundVote := roundVotes[indexes[row-1]]
 			text := roundVote.Serialize(d.DisableEmojis)
 
-			cell := tview.NewTableCell(text)
+			cell := tview.NewTableCell(text).SetReference(indexes[row-1])
+
+			if color := roundsBlockHashesVotes[round].GetColor(roundVote.PrevoteHash); color != "" {
+				cell.SetTextColor(tcell.GetColor(color))
+			}
 
 			if roundVote.IsProposer {
 				cell.SetBackgroundColor(tcell.ColorForestGreen)
 			}
 
-			if roundVote.Address == d.CurrentValidatorAddress {
+			if d.Validators.Validators[indexes[row-1]].IsMine(d.MyValidators) {
 				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
 			}
 
@@ -141,3 +188,16 @@ func (d *AllRoundsTableData) redrawCells() {
 		}
 	}
 }
+
+func (d *AllRoundsTableData) getValidatorWithInfo(index int, roundVotes types.RoundVotes) types.ValidatorWithInfo {
+	validator := types.ValidatorWithInfo{
+		Validator:      d.Validators.Validators[index].Validator,
+		ChainValidator: d.Validators.Validators[index].ChainValidator,
+	}
+
+	if index < len(roundVotes) {
+		validator.RoundVote = roundVotes[index]
+	}
+
+	return validator
+}
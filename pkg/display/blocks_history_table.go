@@ -0,0 +1,207 @@
+package display
+
+import (
+	"fmt"
+	"main/pkg/types"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/rivo/tview"
+)
+
+const BlocksHistoryFixedColumns = 2
+
+type BlocksHistoryTableData struct {
+	tview.TableContentReadOnly
+
+	Validators    []types.ValidatorWithChainValidator
+	BlocksHistory *types.BlocksHistory
+	MyValidators  types.MyValidators
+	DisableEmojis bool
+	Transpose     bool
+	Filter        string
+	SortOrder     types.SortOrder
+
+	cells [][]*tview.TableCell
+	mutex sync.Mutex
+}
+
+func NewBlocksHistoryTableData(disableEmojis bool, transpose bool) *BlocksHistoryTableData {
+	return &BlocksHistoryTableData{
+		Validators:    []types.ValidatorWithChainValidator{},
+		BlocksHistory: types.NewBlocksHistory(0),
+		DisableEmojis: disableEmojis,
+		Transpose:     transpose,
+		cells:         [][]*tview.TableCell{},
+	}
+}
+
+func (d *BlocksHistoryTableData) GetCell(row, column int) *tview.TableCell {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) <= row {
+		return nil
+	}
+
+	if len(d.cells[row]) <= column {
+		return nil
+	}
+
+	return d.cells[row][column]
+}
+
+func (d *BlocksHistoryTableData) GetRowCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.cells)
+}
+
+func (d *BlocksHistoryTableData) GetColumnCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) == 0 {
+		return 0
+	}
+
+	return len(d.cells[0])
+}
+
+func (d *BlocksHistoryTableData) SetBlocksHistory(
+	validators []types.ValidatorWithChainValidator,
+	blocksHistory *types.BlocksHistory,
+	myValidators types.MyValidators,
+) {
+	d.Validators = validators
+
+	if blocksHistory != nil {
+		d.BlocksHistory = blocksHistory
+	}
+
+	d.MyValidators = myValidators
+
+	d.redrawCells()
+}
+
+func (d *BlocksHistoryTableData) SetTranspose(transpose bool) {
+	d.Transpose = transpose
+	d.redrawCells()
+}
+
+func (d *BlocksHistoryTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *BlocksHistoryTableData) SetSortOrder(order types.SortOrder) {
+	d.SortOrder = order
+	d.redrawCells()
+}
+
+func (d *BlocksHistoryTableData) redrawCells() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	validators := make([]types.ValidatorWithChainValidator, 0)
+	for _, validator := range d.Validators {
+		if validator.Matches(d.Filter) {
+			validators = append(validators, validator)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return d.SortOrder.Less(
+			types.ValidatorWithInfo{Validator: validators[i].Validator, ChainValidator: validators[i].ChainValidator},
+			types.ValidatorWithInfo{Validator: validators[j].Validator, ChainValidator: validators[j].ChainValidator},
+		)
+	})
+
+	blocks := d.BlocksHistory.Blocks
+	columnsCount := len(blocks) + BlocksHistoryFixedColumns
+
+	d.cells = make([][]*tview.TableCell, len(validators)+1)
+
+	for row := 0; row < len(validators)+1; row++ {
+		d.cells[row] = make([]*tview.TableCell, columnsCount)
+
+		for column := 0; column < columnsCount; column++ {
+			// Newest blocks go first, unless transposed.
+			blockIndex := len(blocks) - 1 - (column - BlocksHistoryFixedColumns)
+			if d.Transpose {
+				blockIndex = column - BlocksHistoryFixedColumns
+			}
+
+			// Table header.
+			if row == 0 {
+				text := "validator"
+				if len(blocks) > 0 {
+					text = fmt.Sprintf(
+						"validator (blocks %d-%d)",
+						blocks[0].Height,
+						blocks[len(blocks)-1].Height,
+					)
+				}
+
+				if column == 1 {
+					text = "missed"
+				} else if column >= BlocksHistoryFixedColumns {
+					// Full heights are too wide to fit, so only displaying the last digits.
+					text = fmt.Sprintf("%03d", blocks[blockIndex].Height%1000)
+				}
+
+				d.cells[row][column] = tview.
+					NewTableCell(text).
+					SetAlign(tview.AlignCenter).
+					SetStyle(tcell.StyleDefault.Bold(true)).
+					SetSelectable(false)
+				continue
+			}
+
+			validator := validators[row-1]
+
+			// First columns are always validators list and missed blocks counter.
+			if column == 0 {
+				d.cells[row][column] = tview.
+					NewTableCell(validator.Serialize()).
+					SetReference(validator.Validator.Index)
+				continue
+			}
+
+			if column == 1 {
+				missed := d.BlocksHistory.GetMissedCount(validator.Validator.Address)
+				cell := tview.
+					NewTableCell(strconv.Itoa(missed)).
+					SetAlign(tview.AlignCenter).
+					SetReference(validator.Validator.Index)
+
+				if missed > 0 {
+					cell.SetTextColor(tcell.ColorRed)
+				}
+
+				d.cells[row][column] = cell
+				continue
+			}
+
+			text := ""
+			if vote, ok := blocks[blockIndex].GetVote(validator.Validator.Address); ok {
+				text = vote.Serialize(d.DisableEmojis)
+			}
+
+			cell := tview.
+				NewTableCell(text).
+				SetAlign(tview.AlignCenter).
+				SetReference(validator.Validator.Index)
+
+			if validator.IsMine(d.MyValidators) {
+				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
+			}
+
+			d.cells[row][column] = cell
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package display
+
+import "main/pkg/types"
+
+type Display interface {
+	Start()
+	Stop()
+	SetState(state *types.State)
+	DebugText(text string)
+	Alert(alert types.Alert)
+}
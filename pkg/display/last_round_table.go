@@ -3,7 +3,9 @@ package display
 import (
 	"fmt"
 	"main/pkg/types"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -12,12 +14,16 @@ import (
 type LastRoundTableData struct {
 	tview.TableContentReadOnly
 
-	Validators              types.ValidatorsWithInfo
-	CurrentValidatorAddress string
-	ConsensusError          error
-	ColumnsCount            int
-	DisableEmojis           bool
-	Transpose               bool
+	Validators       types.ValidatorsWithInfo
+	BlockHashesVotes types.BlockHashesVotes
+	MyValidators     types.MyValidators
+	ConsensusError   error
+	ColumnsCount     int
+	DisableEmojis    bool
+	Transpose        bool
+	Filter           string
+	SortOrder        types.SortOrder
+	Now              time.Time
 
 	cells [][]*tview.TableCell
 	mutex sync.Mutex
@@ -44,6 +50,16 @@ func (d *LastRoundTableData) SetTranspose(transpose bool) {
 	d.redrawData()
 }
 
+func (d *LastRoundTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawData()
+}
+
+func (d *LastRoundTableData) SetSortOrder(order types.SortOrder) {
+	d.SortOrder = order
+	d.redrawData()
+}
+
 func (d *LastRoundTableData) GetCell(row, column int) *tview.TableCell {
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
@@ -79,15 +95,16 @@ func (d *LastRoundTableData) GetColumnCount() int {
 
 func (d *LastRoundTableData) SetValidators(
 	validators types.ValidatorsWithInfo,
+	blockHashesVotes types.BlockHashesVotes,
 	consensusError error,
-	statusResult *types.TendermintStatusResult,
+	myValidators types.MyValidators,
+	now time.Time,
 ) {
 	d.Validators = validators
+	d.BlockHashesVotes = blockHashesVotes
 	d.ConsensusError = consensusError
-
-	if statusResult != nil {
-		d.CurrentValidatorAddress = statusResult.ValidatorInfo.Address
-	}
+	d.MyValidators = myValidators
+	d.Now = now
 
 	d.redrawData()
 }
@@ -99,15 +116,27 @@ func (d *LastRoundTableData) redrawData() {
 	if d.ConsensusError != nil {
 		d.cells = [][]*tview.TableCell{
 			{
-				tview.NewTableCell(fmt.Sprintf(" Error fetching consensus: %s", d.ConsensusError)),
+				tview.NewTableCell(fmt.Sprintf(" Error fetching consensus: %s", d.ConsensusError)).
+					SetSelectable(false),
 			},
 		}
 		return
 	}
 
-	rowsCount := len(d.Validators)/d.ColumnsCount + 1
-	if len(d.Validators)%d.ColumnsCount == 0 {
-		rowsCount = len(d.Validators) / d.ColumnsCount
+	validators := make(types.ValidatorsWithInfo, 0)
+	for _, validator := range d.Validators {
+		if validator.Matches(d.Filter) {
+			validators = append(validators, validator)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return d.SortOrder.Less(validators[i], validators[j])
+	})
+
+	rowsCount := len(validators)/d.ColumnsCount + 1
+	if len(validators)%d.ColumnsCount == 0 {
+		rowsCount = len(validators) / d.ColumnsCount
 	}
 
 	d.cells = make([][]*tview.TableCell, rowsCount)
@@ -125,17 +154,27 @@ func (d *LastRoundTableData) redrawData() {
 
 			text := ""
 
-			if index < len(d.Validators) {
-				text = d.Validators[index].Serialize(d.DisableEmojis)
+			if index < len(validators) {
+				text = validators[index].Serialize(d.DisableEmojis, d.Now)
 			}
 
 			cell := tview.NewTableCell(text)
 
-			if index < len(d.Validators) && d.Validators[index].RoundVote.IsProposer {
+			if index < len(validators) {
+				cell.SetReference(validators[index].Validator.Index)
+
+				if color := d.BlockHashesVotes.GetColor(validators[index].RoundVote.PrevoteHash); color != "" {
+					cell.SetTextColor(tcell.GetColor(color))
+				}
+			} else {
+				cell.SetSelectable(false)
+			}
+
+			if index < len(validators) && validators[index].RoundVote.IsProposer {
 				cell.SetBackgroundColor(tcell.ColorForestGreen)
 			}
 
-			if index < len(d.Validators) && d.Validators[index].Validator.Address == d.CurrentValidatorAddress {
+			if index < len(validators) && validators[index].IsMine(d.MyValidators) {
 				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
 			}
 
@@ -0,0 +1,162 @@
+package display
+
+import (
+	"main/pkg/types"
+	"strconv"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type PeersTableData struct {
+	tview.TableContentReadOnly
+
+	Peers  types.PeersWithInfo
+	Height string
+	Filter string
+
+	cells [][]*tview.TableCell
+	mutex sync.Mutex
+}
+
+func NewPeersTableData() *PeersTableData {
+	return &PeersTableData{
+		Peers: make(types.PeersWithInfo, 0),
+		cells: [][]*tview.TableCell{},
+	}
+}
+
+func (d *PeersTableData) GetCell(row, column int) *tview.TableCell {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) <= row {
+		return nil
+	}
+
+	if len(d.cells[row]) <= column {
+		return nil
+	}
+
+	return d.cells[row][column]
+}
+
+func (d *PeersTableData) GetRowCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.cells)
+}
+
+func (d *PeersTableData) GetColumnCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) == 0 {
+		return 0
+	}
+
+	return len(d.cells[0])
+}
+
+func (d *PeersTableData) SetPeers(peers types.PeersWithInfo, height string) {
+	d.Peers = peers
+	d.Height = height
+
+	d.redrawCells()
+}
+
+func (d *PeersTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *PeersTableData) redrawCells() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.Peers) == 0 {
+		d.cells = [][]*tview.TableCell{
+			{
+				tview.NewTableCell(" Peers are not fetched yet or the node has no peers").
+					SetSelectable(false),
+			},
+		}
+		return
+	}
+
+	headers := []string{
+		"node ID",
+		"moniker",
+		"IP",
+		"direction",
+		"height",
+		"round",
+		"step",
+		"has our prevotes",
+		"has our precommits",
+	}
+
+	d.cells = make([][]*tview.TableCell, 1, len(d.Peers)+1)
+	d.cells[0] = make([]*tview.TableCell, len(headers))
+
+	for column, header := range headers {
+		d.cells[0][column] = tview.
+			NewTableCell(header).
+			SetAlign(tview.AlignCenter).
+			SetStyle(tcell.StyleDefault.Bold(true)).
+			SetSelectable(false)
+	}
+
+	for _, peer := range d.Peers {
+		if !peer.Matches(d.Filter) {
+			continue
+		}
+
+		texts := []string{
+			" " + peer.ID + " ",
+			" " + peer.Moniker + " ",
+			" " + peer.RemoteIP + " ",
+			" " + d.serializeDirection(peer.IsOutbound) + " ",
+			" " + peer.RoundState.Height + " ",
+			" " + strconv.FormatInt(peer.RoundState.Round, 10) + " ",
+			" " + types.RoundStepName(peer.RoundState.Step) + " ",
+			" " + peer.OurPrevotes.Serialize() + " ",
+			" " + peer.OurPrecommits.Serialize() + " ",
+		}
+
+		// Peers lagging behind our node are highlighted, as they might not be able to relay our votes.
+		textColor := tview.Styles.PrimaryTextColor
+		if peer.RoundState.Height != d.Height {
+			textColor = tcell.ColorYellow
+		}
+
+		row := make([]*tview.TableCell, len(texts))
+
+		for column, text := range texts {
+			cell := tview.NewTableCell(text).SetTextColor(textColor)
+			if column >= 4 && column <= 5 {
+				cell.SetAlign(tview.AlignRight)
+			} else if column >= 7 {
+				cell.SetAlign(tview.AlignCenter)
+			}
+
+			row[column] = cell
+		}
+
+		d.cells = append(d.cells, row)
+	}
+}
+
+func (d *PeersTableData) serializeDirection(isOutbound *bool) string {
+	if isOutbound == nil {
+		return "-"
+	}
+
+	if *isOutbound {
+		return "outbound"
+	}
+
+	return "inbound"
+}
@@ -0,0 +1,170 @@
+package display
+
+import (
+	"fmt"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type ProposersScheduleTableData struct {
+	tview.TableContentReadOnly
+
+	Validators   types.ValidatorsWithInfo
+	Schedule     types.ProposersSchedule
+	Height       int64
+	BlockTime    time.Duration
+	MyValidators types.MyValidators
+	Filter       string
+
+	cells [][]*tview.TableCell
+	mutex sync.Mutex
+}
+
+func NewProposersScheduleTableData() *ProposersScheduleTableData {
+	return &ProposersScheduleTableData{
+		Validators: make(types.ValidatorsWithInfo, 0),
+		cells:      [][]*tview.TableCell{},
+	}
+}
+
+func (d *ProposersScheduleTableData) GetCell(row, column int) *tview.TableCell {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) <= row {
+		return nil
+	}
+
+	if len(d.cells[row]) <= column {
+		return nil
+	}
+
+	return d.cells[row][column]
+}
+
+func (d *ProposersScheduleTableData) GetRowCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.cells)
+}
+
+func (d *ProposersScheduleTableData) GetColumnCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) == 0 {
+		return 0
+	}
+
+	return len(d.cells[0])
+}
+
+func (d *ProposersScheduleTableData) SetSchedule(
+	validators types.ValidatorsWithInfo,
+	schedule types.ProposersSchedule,
+	height int64,
+	blockTime time.Duration,
+	myValidators types.MyValidators,
+) {
+	d.Validators = validators
+	d.Schedule = schedule
+	d.Height = height
+	d.BlockTime = blockTime
+	d.MyValidators = myValidators
+
+	d.redrawCells()
+}
+
+func (d *ProposersScheduleTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *ProposersScheduleTableData) redrawCells() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.Schedule) == 0 {
+		d.cells = [][]*tview.TableCell{
+			{
+				tview.NewTableCell(" Proposers schedule is not calculated yet").
+					SetSelectable(false),
+			},
+		}
+		return
+	}
+
+	headers := []string{"height", "round", "validator", "voting power", "expected in"}
+
+	d.cells = make([][]*tview.TableCell, 1, len(d.Schedule)+1)
+	d.cells[0] = make([]*tview.TableCell, len(headers))
+
+	for column, header := range headers {
+		d.cells[0][column] = tview.
+			NewTableCell(header).
+			SetAlign(tview.AlignCenter).
+			SetStyle(tcell.StyleDefault.Bold(true)).
+			SetSelectable(false)
+	}
+
+	for _, proposer := range d.Schedule {
+		validator, found := utils.Find(d.Validators, func(v types.ValidatorWithInfo) bool {
+			return v.Validator.Address == proposer.Address
+		})
+
+		if found && !validator.Matches(d.Filter) {
+			continue
+		}
+
+		name, votingPower, reference := proposer.Address, "", interface{}(nil)
+		isMine := d.MyValidators.Matches(proposer.Address, "", "")
+		if found {
+			isMine = validator.IsMine(d.MyValidators)
+			name = validator.GetName()
+			votingPower = fmt.Sprintf("%.2f%%", validator.Validator.VotingPowerPercent)
+			reference = validator.Validator.Index
+		}
+
+		texts := []string{
+			" " + strconv.FormatInt(proposer.Height, 10) + " ",
+			" " + strconv.FormatInt(proposer.Round, 10) + " ",
+			" " + name + " ",
+			" " + votingPower + " ",
+			d.serializeExpectedIn(proposer),
+		}
+
+		row := make([]*tview.TableCell, len(texts))
+
+		for column, text := range texts {
+			cell := tview.NewTableCell(text).SetReference(reference)
+			if column != 2 {
+				cell.SetAlign(tview.AlignRight)
+			}
+
+			if isMine {
+				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
+			}
+
+			row[column] = cell
+		}
+
+		d.cells = append(d.cells, row)
+	}
+}
+
+// serializeExpectedIn estimates when the height would be proposed based on the average block time.
+// Next rounds of the current height depend on timeouts and are not estimated.
+func (d *ProposersScheduleTableData) serializeExpectedIn(proposer types.ScheduledProposer) string {
+	if proposer.Height <= d.Height || d.BlockTime == 0 {
+		return " - "
+	}
+
+	return fmt.Sprintf(" ~%s ", utils.SerializeDuration(time.Duration(proposer.Height-d.Height)*d.BlockTime))
+}
@@ -0,0 +1,194 @@
+package display
+
+import (
+	"fmt"
+	"main/pkg/types"
+	"math/big"
+	"strconv"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type StakingSetTableData struct {
+	tview.TableContentReadOnly
+
+	ChainValidators *types.ChainValidators
+	StakingSet      types.StakingSet
+	MyValidators    types.MyValidators
+	Filter          string
+
+	// ValidatorsIndexes are the indexes of the validators in the consensus set by their consensus address.
+	ValidatorsIndexes map[string]int
+
+	cells [][]*tview.TableCell
+	mutex sync.Mutex
+}
+
+func NewStakingSetTableData() *StakingSetTableData {
+	return &StakingSetTableData{
+		StakingSet:        make(types.StakingSet, 0),
+		ValidatorsIndexes: make(map[string]int),
+		cells:             [][]*tview.TableCell{},
+	}
+}
+
+func (d *StakingSetTableData) GetCell(row, column int) *tview.TableCell {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) <= row {
+		return nil
+	}
+
+	if len(d.cells[row]) <= column {
+		return nil
+	}
+
+	return d.cells[row][column]
+}
+
+func (d *StakingSetTableData) GetRowCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.cells)
+}
+
+func (d *StakingSetTableData) GetColumnCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) == 0 {
+		return 0
+	}
+
+	return len(d.cells[0])
+}
+
+func (d *StakingSetTableData) SetStakingSet(
+	chainValidators *types.ChainValidators,
+	validators types.ValidatorsWithInfo,
+	myValidators types.MyValidators,
+) {
+	// Chain validators are only refetched once in a while, so there's no need
+	// to sort them again on every consensus state update.
+	if chainValidators != d.ChainValidators {
+		d.ChainValidators = chainValidators
+		d.StakingSet = make(types.StakingSet, 0)
+		if chainValidators != nil {
+			d.StakingSet = chainValidators.GetStakingSet()
+		}
+	}
+
+	d.ValidatorsIndexes = make(map[string]int, len(validators))
+	for _, validator := range validators {
+		d.ValidatorsIndexes[validator.Validator.Address] = validator.Validator.Index
+	}
+
+	d.MyValidators = myValidators
+
+	d.redrawCells()
+}
+
+func (d *StakingSetTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *StakingSetTableData) redrawCells() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.StakingSet) == 0 {
+		d.cells = [][]*tview.TableCell{
+			{
+				tview.NewTableCell(" Staking set is not fetched yet or is not available for this chain type").
+					SetSelectable(false),
+			},
+		}
+		return
+	}
+
+	headers := []string{"rank", "validator", "status", "tokens", "delegator shares", "commission", "jailed", "to enter active set"}
+
+	d.cells = make([][]*tview.TableCell, 1, len(d.StakingSet)+1)
+	d.cells[0] = make([]*tview.TableCell, len(headers))
+
+	for column, header := range headers {
+		d.cells[0][column] = tview.
+			NewTableCell(header).
+			SetAlign(tview.AlignCenter).
+			SetStyle(tcell.StyleDefault.Bold(true)).
+			SetSelectable(false)
+	}
+
+	for _, stakingSetValidator := range d.StakingSet {
+		chainValidator := stakingSetValidator.ChainValidator
+		if !chainValidator.Matches(d.Filter) {
+			continue
+		}
+
+		// Only the validators in the consensus set have the details to show.
+		reference := interface{}(nil)
+		if index, found := d.ValidatorsIndexes[chainValidator.GetConsensusAddress()]; found {
+			reference = index
+		}
+
+		texts := []string{
+			" " + strconv.Itoa(stakingSetValidator.Rank) + " ",
+			" " + chainValidator.Moniker + " ",
+			" " + string(chainValidator.Status) + " ",
+			" " + d.serializeTokens(chainValidator.Tokens) + " ",
+			fmt.Sprintf(" %.2f ", chainValidator.DelegatorShares),
+			fmt.Sprintf(" %.2f%% ", chainValidator.Commission*100),
+			" " + d.serializeJailed(chainValidator.Jailed) + " ",
+			" " + d.serializeTokens(stakingSetValidator.TokensToEnter) + " ",
+		}
+
+		textColor := tview.Styles.PrimaryTextColor
+		if chainValidator.Jailed {
+			textColor = tcell.ColorRed
+		} else if stakingSetValidator.IsNearCutoff {
+			textColor = tcell.ColorYellow
+		} else if !stakingSetValidator.IsActive {
+			textColor = tcell.ColorGray
+		}
+
+		row := make([]*tview.TableCell, len(texts))
+
+		for column, text := range texts {
+			cell := tview.NewTableCell(text).
+				SetReference(reference).
+				SetTextColor(textColor)
+			if column != 1 && column != 2 {
+				cell.SetAlign(tview.AlignRight)
+			}
+
+			if chainValidator.IsMine(d.MyValidators) {
+				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
+			}
+
+			row[column] = cell
+		}
+
+		d.cells = append(d.cells, row)
+	}
+}
+
+func (d *StakingSetTableData) serializeJailed(jailed bool) string {
+	if jailed {
+		return "yes"
+	}
+
+	return "no"
+}
+
+func (d *StakingSetTableData) serializeTokens(tokens *big.Int) string {
+	if tokens == nil {
+		return "-"
+	}
+
+	return tokens.String()
+}
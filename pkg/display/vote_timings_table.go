@@ -0,0 +1,167 @@
+package display
+
+import (
+	"fmt"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type VoteTimingsTableData struct {
+	tview.TableContentReadOnly
+
+	Validators     types.ValidatorsWithInfo
+	StartTime      time.Time
+	Now            time.Time
+	MyValidators   types.MyValidators
+	ConsensusError error
+	DisableEmojis  bool
+	Filter         string
+
+	cells [][]*tview.TableCell
+	mutex sync.Mutex
+}
+
+func NewVoteTimingsTableData(disableEmojis bool) *VoteTimingsTableData {
+	return &VoteTimingsTableData{
+		Validators:    make(types.ValidatorsWithInfo, 0),
+		DisableEmojis: disableEmojis,
+		cells:         [][]*tview.TableCell{},
+	}
+}
+
+func (d *VoteTimingsTableData) GetCell(row, column int) *tview.TableCell {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) <= row {
+		return nil
+	}
+
+	if len(d.cells[row]) <= column {
+		return nil
+	}
+
+	return d.cells[row][column]
+}
+
+func (d *VoteTimingsTableData) GetRowCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return len(d.cells)
+}
+
+func (d *VoteTimingsTableData) GetColumnCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.cells) == 0 {
+		return 0
+	}
+
+	return len(d.cells[0])
+}
+
+func (d *VoteTimingsTableData) SetValidators(
+	validators types.ValidatorsWithInfo,
+	startTime time.Time,
+	consensusError error,
+	myValidators types.MyValidators,
+	now time.Time,
+) {
+	d.Validators = validators
+	d.StartTime = startTime
+	d.Now = now
+	d.ConsensusError = consensusError
+	d.MyValidators = myValidators
+
+	d.redrawCells()
+}
+
+func (d *VoteTimingsTableData) SetFilter(filter string) {
+	d.Filter = filter
+	d.redrawCells()
+}
+
+func (d *VoteTimingsTableData) redrawCells() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.ConsensusError != nil {
+		d.cells = [][]*tview.TableCell{
+			{
+				tview.NewTableCell(fmt.Sprintf(" Error fetching consensus: %s", d.ConsensusError)).
+					SetSelectable(false),
+			},
+		}
+		return
+	}
+
+	// Ranking the validators from the slowest to the fastest, so the ones
+	// that are lagging behind are displayed first.
+	validators := make(types.ValidatorsWithInfo, 0)
+	for _, validator := range d.Validators {
+		if validator.Matches(d.Filter) {
+			validators = append(validators, validator)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return types.SortBySlowestFirst.Less(validators[i], validators[j])
+	})
+
+	headers := []string{"rank", "validator", "prevote after", "precommit after"}
+
+	d.cells = make([][]*tview.TableCell, len(validators)+1)
+	d.cells[0] = make([]*tview.TableCell, len(headers))
+
+	for column, header := range headers {
+		d.cells[0][column] = tview.
+			NewTableCell(header).
+			SetAlign(tview.AlignCenter).
+			SetStyle(tcell.StyleDefault.Bold(true)).
+			SetSelectable(false)
+	}
+
+	for index, validator := range validators {
+		prevoteLatency, prevoted := validator.RoundVote.GetPrevoteLatency(d.StartTime)
+		precommitLatency, precommitted := validator.RoundVote.GetPrecommitLatency(d.StartTime)
+
+		texts := []string{
+			" " + strconv.Itoa(index+1) + " ",
+			validator.Serialize(d.DisableEmojis, d.Now),
+			serializeLatency(prevoteLatency, prevoted),
+			serializeLatency(precommitLatency, precommitted),
+		}
+
+		d.cells[index+1] = make([]*tview.TableCell, len(texts))
+
+		for column, text := range texts {
+			cell := tview.NewTableCell(text).SetReference(validator.Validator.Index)
+			if column != 1 {
+				cell.SetAlign(tview.AlignRight)
+			}
+
+			if validator.IsMine(d.MyValidators) {
+				cell.SetBackgroundColor(tcell.ColorMediumTurquoise)
+			}
+
+			d.cells[index+1][column] = cell
+		}
+	}
+}
+
+func serializeLatency(latency time.Duration, ok bool) string {
+	if !ok {
+		return " - "
+	}
+
+	return fmt.Sprintf(" %s ", utils.SerializeDuration(latency))
+}
@@ -6,6 +6,7 @@ import (
 	"main/pkg/types"
 	"main/static"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
@@ -14,30 +15,61 @@ import (
 )
 
 const (
-	ModeLastRound = iota
-	ModeAllRounds = iota
+	ModeLastRound     = iota
+	ModeAllRounds     = iota
+	ModeBlocksHistory = iota
+	ModeVoteTimings   = iota
+	ModeProposers     = iota
+	ModeStakingSet    = iota
+	ModePeers         = iota
+)
+
+const (
+	ReplayCommandSeekBackward = iota
+	ReplayCommandSeekForward  = iota
+	ReplayCommandSpeedUp      = iota
+	ReplayCommandSlowDown     = iota
 )
 
 const (
 	DefaultColumnsCount = 3
 	RowsAmount          = 10
+	ColumnsAmount       = 12
 	DebugBlockHeight    = 2
 	DefaultMode         = ModeLastRound
+	AlertFlashDuration  = 3 * time.Second
 )
 
 type Wrapper struct {
 	ConsensusInfoTextView *tview.TextView
 	ChainInfoTextView     *tview.TextView
+	NodeHealthTextView    *tview.TextView
+	ProposalTextView      *tview.TextView
 	ProgressTextView      *tview.TextView
 	DebugTextView         *tview.TextView
+	RPCStatusTextView     *tview.TextView
+	DebugBlock            *tview.Flex
 	LastRoundTable        *tview.Table
 	LastRoundTableData    *LastRoundTableData
 	AllRoundsTable        *tview.Table
 	AllRoundsTableData    *AllRoundsTableData
+	BlocksHistoryTable    *tview.Table
+	BlocksHistoryData     *BlocksHistoryTableData
+	VoteTimingsTable      *tview.Table
+	VoteTimingsTableData  *VoteTimingsTableData
+	ProposersTable        *tview.Table
+	ProposersTableData    *ProposersScheduleTableData
+	StakingSetTable       *tview.Table
+	StakingSetTableData   *StakingSetTableData
+	PeersTable            *tview.Table
+	PeersTableData        *PeersTableData
 	Grid                  *tview.Grid
 	Pages                 *tview.Pages
 	App                   *tview.Application
 	HelpModal             *tview.Modal
+	ChainPicker           *tview.List
+	SearchInput           *tview.InputField
+	ValidatorInfoTextView *tview.TextView
 
 	InfoBlockWidth int
 	ColumnsCount   int
@@ -52,34 +84,87 @@ type Wrapper struct {
 
 	IsHelpDisplayed bool
 
+	Chains                 []*configPkg.Config
+	SwitchChainChannel     chan int
+	ReplayChannel          chan int
+	IsReplay               bool
+	IsChainPickerDisplayed bool
+	IsSearchDisplayed      bool
+
+	// State is set from the refresh goroutines and read from the UI goroutine.
+	State                    atomic.Pointer[types.State]
+	SelectedValidator        int
+	IsValidatorInfoDisplayed bool
+
 	DisableEmojis bool
 	Transpose     bool
+	SortOrder     types.SortOrder
 	Timezone      *time.Location
+
+	IsBellPending atomic.Bool
 }
 
 func NewWrapper(
 	config *configPkg.Config,
+	chains []*configPkg.Config,
 	logger zerolog.Logger,
 	pauseChannel chan bool,
+	switchChainChannel chan int,
+	replayChannel chan int,
 	appVersion string,
 ) *Wrapper {
 	lastRoundTableData := NewLastRoundTableData(DefaultColumnsCount, config.DisableEmojis, false)
 	allRoundsTableData := NewAllRoundsTableData(config.DisableEmojis, false)
+	blocksHistoryData := NewBlocksHistoryTableData(config.DisableEmojis, false)
+	voteTimingsTableData := NewVoteTimingsTableData(config.DisableEmojis)
+	proposersTableData := NewProposersScheduleTableData()
+	stakingSetTableData := NewStakingSetTableData()
+	peersTableData := NewPeersTableData()
 
 	helpTextBytes, _ := static.TemplatesFs.ReadFile("help.txt")
 	helpText := strings.ReplaceAll(string(helpTextBytes), "{{ Version }}", appVersion)
 
 	lastRoundTable := tview.NewTable().
 		SetBorders(false).
-		SetSelectable(false, false).
+		SetSelectable(true, true).
 		SetContent(lastRoundTableData)
 
 	allRoundsTable := tview.NewTable().
 		SetBorders(false).
-		SetSelectable(false, false).
+		SetSelectable(true, false).
 		SetContent(allRoundsTableData).
 		SetFixed(1, 1)
 
+	blocksHistoryTable := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetContent(blocksHistoryData).
+		SetFixed(1, BlocksHistoryFixedColumns)
+
+	voteTimingsTable := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetContent(voteTimingsTableData).
+		SetFixed(1, 0)
+
+	proposersTable := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetContent(proposersTableData).
+		SetFixed(1, 0)
+
+	stakingSetTable := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetContent(stakingSetTableData).
+		SetFixed(1, 0)
+
+	peersTable := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetContent(peersTableData).
+		SetFixed(1, 0)
+
 	consensusInfoTextView := tview.NewTextView().
 		SetDynamicColors(true).
 		SetRegions(true)
@@ -88,6 +173,14 @@ func NewWrapper(
 		SetDynamicColors(true).
 		SetRegions(true)
 
+	nodeHealthTextView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetRegions(true)
+
+	proposalTextView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetRegions(true)
+
 	progressTextView := tview.NewTextView().
 		SetDynamicColors(true).
 		SetRegions(true)
@@ -96,11 +189,38 @@ func NewWrapper(
 		SetDynamicColors(true).
 		SetRegions(true)
 
+	rpcStatusTextView := tview.NewTextView().
+		SetDynamicColors(true)
+
+	debugBlock := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(rpcStatusTextView, 1, 0, false).
+		AddItem(debugTextView, 0, 1, false)
+
 	helpModal := tview.NewModal().SetText(helpText)
 
+	searchInput := tview.NewInputField().
+		SetLabel(" Search by moniker or address: ").
+		SetFieldWidth(0)
+	searchInput.SetBorder(true)
+
+	validatorInfoTextView := tview.NewTextView().
+		SetDynamicColors(true)
+	validatorInfoTextView.SetBorder(true).SetTitle(" Validator info (Esc to close) ")
+
+	chainPicker := tview.NewList()
+	chainPicker.SetBorder(true).SetTitle(" Select chain ")
+
+	for index, chain := range chains {
+		chainPicker.AddItem(chain.GetName(), " "+strings.Join(chain.RPCHosts, ", "), 0, nil)
+		if chain == config {
+			chainPicker.SetCurrentItem(index)
+		}
+	}
+
 	grid := tview.NewGrid().
 		SetRows(0, 0, 0, 0, 0, 0, 0, 0, 0, 0).
-		SetColumns(0, 0, 0, 0, 0, 0).
+		SetColumns(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0).
 		SetBorders(true)
 
 	pages := tview.NewPages().AddPage("grid", grid, true, true)
@@ -109,14 +229,31 @@ func NewWrapper(
 
 	return &Wrapper{
 		ChainInfoTextView:     chainInfoTextView,
+		NodeHealthTextView:    nodeHealthTextView,
 		ConsensusInfoTextView: consensusInfoTextView,
+		ProposalTextView:      proposalTextView,
 		ProgressTextView:      progressTextView,
 		DebugTextView:         debugTextView,
+		RPCStatusTextView:     rpcStatusTextView,
+		DebugBlock:            debugBlock,
 		LastRoundTable:        lastRoundTable,
 		LastRoundTableData:    lastRoundTableData,
 		AllRoundsTable:        allRoundsTable,
 		AllRoundsTableData:    allRoundsTableData,
+		BlocksHistoryTable:    blocksHistoryTable,
+		BlocksHistoryData:     blocksHistoryData,
+		VoteTimingsTable:      voteTimingsTable,
+		VoteTimingsTableData:  voteTimingsTableData,
+		ProposersTable:        proposersTable,
+		ProposersTableData:    proposersTableData,
+		StakingSetTable:       stakingSetTable,
+		StakingSetTableData:   stakingSetTableData,
+		PeersTable:            peersTable,
+		PeersTableData:        peersTableData,
 		HelpModal:             helpModal,
+		ChainPicker:           chainPicker,
+		SearchInput:           searchInput,
+		ValidatorInfoTextView: validatorInfoTextView,
 		Grid:                  grid,
 		Pages:                 pages,
 		App:                   app,
@@ -128,6 +265,10 @@ func NewWrapper(
 		PauseChannel:          pauseChannel,
 		IsPaused:              false,
 		IsHelpDisplayed:       false,
+		Chains:                chains,
+		SwitchChainChannel:    switchChainChannel,
+		ReplayChannel:         replayChannel,
+		IsReplay:              config.IsReplay(),
 		DisableEmojis:         config.DisableEmojis,
 		Transpose:             false,
 		Timezone:              config.Timezone,
@@ -135,7 +276,69 @@ func NewWrapper(
 }
 
 func (w *Wrapper) Start() {
+	w.ChainPicker.SetSelectedFunc(func(index int, _ string, _ string, _ rune) {
+		w.SwitchChain(index)
+	})
+
+	for _, table := range []*tview.Table{
+		w.LastRoundTable,
+		w.AllRoundsTable,
+		w.BlocksHistoryTable,
+		w.VoteTimingsTable,
+		w.ProposersTable,
+		w.StakingSetTable,
+	} {
+		table := table
+		table.SetSelectedFunc(func(row, column int) {
+			if index, ok := table.GetCell(row, column).GetReference().(int); ok {
+				w.ShowValidatorInfo(index)
+			}
+		})
+	}
+
+	w.SearchInput.SetChangedFunc(func(text string) {
+		w.LastRoundTableData.SetFilter(text)
+		w.AllRoundsTableData.SetFilter(text)
+		w.BlocksHistoryData.SetFilter(text)
+		w.VoteTimingsTableData.SetFilter(text)
+		w.ProposersTableData.SetFilter(text)
+		w.StakingSetTableData.SetFilter(text)
+		w.PeersTableData.SetFilter(text)
+	})
+
+	w.SearchInput.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			w.SearchInput.SetText("")
+		}
+
+		w.ToggleSearch()
+	})
+
 	w.App.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// Search input handles its input by itself.
+		if w.IsSearchDisplayed {
+			return event
+		}
+
+		if w.IsValidatorInfoDisplayed {
+			if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyEnter {
+				w.HideValidatorInfo()
+				return nil
+			}
+
+			return event
+		}
+
+		// Chain picker handles its input by itself.
+		if w.IsChainPickerDisplayed {
+			if event.Key() == tcell.KeyEscape || event.Rune() == 'c' {
+				w.ToggleChainPicker()
+				return nil
+			}
+
+			return event
+		}
+
 		if event.Rune() == 'q' {
 			w.App.Stop()
 		}
@@ -168,6 +371,15 @@ func (w *Wrapper) Start() {
 			w.Transpose = !w.Transpose
 			w.LastRoundTableData.SetTranspose(w.Transpose)
 			w.AllRoundsTableData.SetTranspose(w.Transpose)
+			w.BlocksHistoryData.SetTranspose(w.Transpose)
+		}
+
+		if event.Rune() == 'o' {
+			w.ChangeSortOrder()
+		}
+
+		if event.Rune() == 'c' && len(w.Chains) > 1 {
+			w.ToggleChainPicker()
 		}
 
 		if event.Rune() == 'p' {
@@ -175,27 +387,64 @@ func (w *Wrapper) Start() {
 			w.PauseChannel <- w.IsPaused
 		}
 
+		if w.IsReplay {
+			w.HandleReplayKey(event.Rune())
+		}
+
+		if event.Rune() == '/' {
+			w.ToggleSearch()
+			return nil
+		}
+
 		if event.Key() == tcell.KeyTAB {
 			w.ChangeMode()
 		}
 
+		// Tables use some letters for navigation, which would conflict with shortcuts.
+		if event.Key() == tcell.KeyRune {
+			return nil
+		}
+
 		return event
 	})
 
 	w.Grid.SetBackgroundColor(tcell.ColorDefault)
 	w.LastRoundTable.SetBackgroundColor(tcell.ColorDefault)
 	w.AllRoundsTable.SetBackgroundColor(tcell.ColorDefault)
+	w.BlocksHistoryTable.SetBackgroundColor(tcell.ColorDefault)
+	w.VoteTimingsTable.SetBackgroundColor(tcell.ColorDefault)
+	w.ProposersTable.SetBackgroundColor(tcell.ColorDefault)
+	w.StakingSetTable.SetBackgroundColor(tcell.ColorDefault)
+	w.PeersTable.SetBackgroundColor(tcell.ColorDefault)
 	w.ChainInfoTextView.SetBackgroundColor(tcell.ColorDefault)
+	w.NodeHealthTextView.SetBackgroundColor(tcell.ColorDefault)
+	w.ProposalTextView.SetBackgroundColor(tcell.ColorDefault)
+	w.ChainPicker.SetBackgroundColor(tcell.ColorDefault)
+	w.SearchInput.SetBackgroundColor(tcell.ColorDefault)
+	w.ValidatorInfoTextView.SetBackgroundColor(tcell.ColorDefault)
 	w.ConsensusInfoTextView.SetBackgroundColor(tcell.ColorDefault)
 	w.ProgressTextView.SetBackgroundColor(tcell.ColorDefault)
 	w.DebugTextView.SetBackgroundColor(tcell.ColorDefault)
+	w.RPCStatusTextView.SetBackgroundColor(tcell.ColorDefault)
+	w.DebugBlock.SetBackgroundColor(tcell.ColorDefault)
 
 	w.Redraw()
 
 	_, _ = fmt.Fprint(w.ChainInfoTextView, "Loading...")
+	_, _ = fmt.Fprint(w.NodeHealthTextView, "Loading...")
+	_, _ = fmt.Fprint(w.ProposalTextView, "Loading...")
 	_, _ = fmt.Fprint(w.ConsensusInfoTextView, "Loading...")
 	_, _ = fmt.Fprint(w.ProgressTextView, "Loading...")
 
+	// The screen is only accessible while drawing, so the bell is rung on the next redraw.
+	w.App.SetBeforeDrawFunc(func(screen tcell.Screen) bool {
+		if w.IsBellPending.Swap(false) {
+			_ = screen.Beep()
+		}
+
+		return false
+	})
+
 	w.App.SetAfterDrawFunc(func(screen tcell.Screen) {
 		_, _, width, _ := w.LastRoundTable.GetInnerRect()
 		columns := width / 50
@@ -209,6 +458,10 @@ func (w *Wrapper) Start() {
 	}
 }
 
+func (w *Wrapper) Stop() {
+	w.App.Stop()
+}
+
 func (w *Wrapper) ToggleDebug() {
 	w.DebugEnabled = !w.DebugEnabled
 
@@ -221,29 +474,148 @@ func (w *Wrapper) ToggleHelp() {
 	w.Redraw()
 }
 
+func (w *Wrapper) ToggleSearch() {
+	w.IsSearchDisplayed = !w.IsSearchDisplayed
+
+	w.Redraw()
+}
+
+func (w *Wrapper) ToggleChainPicker() {
+	w.IsChainPickerDisplayed = !w.IsChainPickerDisplayed
+
+	w.Redraw()
+}
+
+func (w *Wrapper) ShowValidatorInfo(index int) {
+	w.SelectedValidator = index
+	w.IsValidatorInfoDisplayed = true
+	w.RedrawValidatorInfo()
+
+	w.Redraw()
+}
+
+func (w *Wrapper) HideValidatorInfo() {
+	w.IsValidatorInfoDisplayed = false
+
+	w.Redraw()
+}
+
+func (w *Wrapper) RedrawValidatorInfo() {
+	state := w.State.Load()
+	if state == nil {
+		return
+	}
+
+	state.RLock()
+	defer state.RUnlock()
+
+	w.redrawValidatorInfo(state)
+}
+
+func (w *Wrapper) redrawValidatorInfo(state *types.State) {
+	w.ValidatorInfoTextView.SetText(state.SerializeValidatorInfo(w.SelectedValidator, w.DisableEmojis))
+}
+
+func (w *Wrapper) SwitchChain(index int) {
+	w.IsChainPickerDisplayed = false
+	w.Timezone = w.Chains[index].Timezone
+
+	w.Redraw()
+
+	w.SwitchChainChannel <- index
+}
+
 func (w *Wrapper) SetState(state *types.State) {
+	w.setState(state)
+
+	// Drawing waits for the UI goroutine, which might be waiting for the state's lock itself,
+	// so it should only be done after the state is released.
+	w.App.Draw()
+}
+
+func (w *Wrapper) setState(state *types.State) {
+	state.RLock()
+	defer state.RUnlock()
+
+	w.State.Store(state)
+
+	if w.IsValidatorInfoDisplayed {
+		w.redrawValidatorInfo(state)
+	}
+
+	myValidators := state.GetMyValidators()
+
 	w.LastRoundTableData.SetValidators(
 		state.GetValidatorsWithInfo(),
+		state.GetPrevotesByBlockHash(),
 		state.ConsensusStateError,
-		state.NodeStatus,
+		myValidators,
+		state.Now(),
 	)
 	w.AllRoundsTableData.SetValidators(
 		state.GetValidatorsWithInfoAndAllRoundVotes(),
-		state.NodeStatus,
+		myValidators,
+	)
+	w.BlocksHistoryData.SetBlocksHistory(
+		state.GetValidatorsWithInfoAndAllRoundVotes().Validators,
+		state.BlocksHistory,
+		myValidators,
+	)
+	w.VoteTimingsTableData.SetValidators(
+		state.GetValidatorsWithInfo(),
+		state.StartTime,
+		state.ConsensusStateError,
+		myValidators,
+		state.Now(),
+	)
+	w.ProposersTableData.SetSchedule(
+		state.GetValidatorsWithInfo(),
+		state.ProposersSchedule,
+		state.Height,
+		state.BlockTime,
+		myValidators,
+	)
+	w.StakingSetTableData.SetStakingSet(
+		state.ChainValidators,
+		state.GetValidatorsWithInfo(),
+		myValidators,
 	)
 
+	// Peers are compared with the height from the same consensus state dump they come from.
+	peersHeight := ""
+	if state.DumpConsensusState != nil {
+		peersHeight = state.DumpConsensusState.Height
+	}
+
+	w.PeersTableData.SetPeers(state.GetPeersWithInfo(), peersHeight)
+
+	w.RPCStatusTextView.SetText(state.SerializeRPCEndpoints())
+
 	w.ConsensusInfoTextView.Clear()
 	w.ChainInfoTextView.Clear()
+	w.NodeHealthTextView.Clear()
+	w.ProposalTextView.Clear()
 	w.ProgressTextView.Clear()
 	_, _ = fmt.Fprint(w.ConsensusInfoTextView, state.SerializeConsensus(w.Timezone))
 	_, _ = fmt.Fprint(w.ChainInfoTextView, state.SerializeChainInfo(w.Timezone))
+	_, _ = fmt.Fprint(w.NodeHealthTextView, state.SerializeNodeHealth())
+	_, _ = fmt.Fprint(w.ProposalTextView, state.SerializeProposalInfo())
+
+	_, _, width, height := w.ProgressTextView.GetInnerRect()
+
+	// Leaving the space for the voting power prevoted for each block below the progressbars.
+	prevotesByBlockHash := state.SerializePrevotesByBlockHash()
+	if prevotesByBlockHash != "" {
+		height -= strings.Count(prevotesByBlockHash, "\n") + 1
+	}
 
-	_, _, width, height := w.ConsensusInfoTextView.GetInnerRect()
 	_, _ = fmt.Fprint(w.ProgressTextView, state.SerializePrevotesProgressbar(width, height/2))
 	_, _ = fmt.Fprint(w.ProgressTextView, "\n")
 	_, _ = fmt.Fprint(w.ProgressTextView, state.SerializePrecommitsProgressbar(width, height/2))
 
-	w.App.Draw()
+	if prevotesByBlockHash != "" {
+		_, _ = fmt.Fprint(w.ProgressTextView, prevotesByBlockHash)
+	}
 }
 
 func (w *Wrapper) DebugText(text string) {
@@ -251,6 +623,20 @@ func (w *Wrapper) DebugText(text string) {
 	w.DebugTextView.ScrollToEnd()
 }
 
+func (w *Wrapper) Alert(_ types.Alert) {
+	w.IsBellPending.Store(true)
+
+	w.App.QueueUpdateDraw(func() {
+		w.Grid.SetBordersColor(tcell.ColorRed)
+	})
+
+	time.AfterFunc(AlertFlashDuration, func() {
+		w.App.QueueUpdateDraw(func() {
+			w.Grid.SetBordersColor(tview.Styles.GraphicsColor)
+		})
+	})
+}
+
 func (w *Wrapper) ChangeInfoBlockHeight(increase bool) {
 	if increase && w.InfoBlockWidth+1 <= RowsAmount-DebugBlockHeight-1 {
 		w.InfoBlockWidth++
@@ -273,12 +659,44 @@ func (w *Wrapper) ChangeColumnsCount(increase bool) {
 	w.Redraw()
 }
 
+func (w *Wrapper) ChangeSortOrder() {
+	w.SortOrder = w.SortOrder.Next()
+	w.Logger.Info().Str("order", w.SortOrder.String()).Msg("Sorting validators")
+
+	w.LastRoundTableData.SetSortOrder(w.SortOrder)
+	w.AllRoundsTableData.SetSortOrder(w.SortOrder)
+	w.BlocksHistoryData.SetSortOrder(w.SortOrder)
+}
+
+func (w *Wrapper) HandleReplayKey(key rune) {
+	switch key {
+	case ',':
+		w.ReplayChannel <- ReplayCommandSeekBackward
+	case '.':
+		w.ReplayChannel <- ReplayCommandSeekForward
+	case '+', '=':
+		w.ReplayChannel <- ReplayCommandSpeedUp
+	case '-':
+		w.ReplayChannel <- ReplayCommandSlowDown
+	}
+}
+
 func (w *Wrapper) ChangeMode() {
 	switch w.Mode {
-	case ModeAllRounds:
-		w.Mode = ModeLastRound
 	case ModeLastRound:
 		w.Mode = ModeAllRounds
+	case ModeAllRounds:
+		w.Mode = ModeBlocksHistory
+	case ModeBlocksHistory:
+		w.Mode = ModeVoteTimings
+	case ModeVoteTimings:
+		w.Mode = ModeProposers
+	case ModeProposers:
+		w.Mode = ModeStakingSet
+	case ModeStakingSet:
+		w.Mode = ModePeers
+	case ModePeers:
+		w.Mode = ModeLastRound
 	default:
 		w.Mode = ModeLastRound
 	}
@@ -287,21 +705,44 @@ func (w *Wrapper) ChangeMode() {
 }
 
 func (w *Wrapper) Redraw() {
-	table := w.LastRoundTable
-	if w.Mode == ModeAllRounds {
+	var table *tview.Table
+
+	switch w.Mode {
+	case ModeAllRounds:
 		table = w.AllRoundsTable
+	case ModeBlocksHistory:
+		table = w.BlocksHistoryTable
+	case ModeVoteTimings:
+		table = w.VoteTimingsTable
+	case ModeProposers:
+		table = w.ProposersTable
+	case ModeStakingSet:
+		table = w.StakingSetTable
+	case ModePeers:
+		table = w.PeersTable
+	default:
+		table = w.LastRoundTable
 	}
 
 	w.Grid.RemoveItem(w.ConsensusInfoTextView)
 	w.Grid.RemoveItem(w.ChainInfoTextView)
+	w.Grid.RemoveItem(w.NodeHealthTextView)
+	w.Grid.RemoveItem(w.ProposalTextView)
 	w.Grid.RemoveItem(w.ProgressTextView)
 	w.Grid.RemoveItem(w.LastRoundTable)
 	w.Grid.RemoveItem(w.AllRoundsTable)
-	w.Grid.RemoveItem(w.DebugTextView)
-
-	w.Grid.AddItem(w.ConsensusInfoTextView, 0, 0, w.InfoBlockWidth, 2, 1, 1, false)
-	w.Grid.AddItem(w.ChainInfoTextView, 0, 2, w.InfoBlockWidth, 2, 1, 1, false)
-	w.Grid.AddItem(w.ProgressTextView, 0, 4, w.InfoBlockWidth, 2, 1, 1, false)
+	w.Grid.RemoveItem(w.BlocksHistoryTable)
+	w.Grid.RemoveItem(w.VoteTimingsTable)
+	w.Grid.RemoveItem(w.ProposersTable)
+	w.Grid.RemoveItem(w.StakingSetTable)
+	w.Grid.RemoveItem(w.PeersTable)
+	w.Grid.RemoveItem(w.DebugBlock)
+
+	w.Grid.AddItem(w.ConsensusInfoTextView, 0, 0, w.InfoBlockWidth, 3, 1, 1, false)
+	w.Grid.AddItem(w.ProposalTextView, 0, 3, w.InfoBlockWidth, 2, 1, 1, false)
+	w.Grid.AddItem(w.ChainInfoTextView, 0, 5, w.InfoBlockWidth, 2, 1, 1, false)
+	w.Grid.AddItem(w.NodeHealthTextView, 0, 7, w.InfoBlockWidth, 3, 1, 1, false)
+	w.Grid.AddItem(w.ProgressTextView, 0, ColumnsAmount-2, w.InfoBlockWidth, 2, 1, 1, false)
 
 	if w.DebugEnabled {
 		w.Grid.AddItem(
@@ -309,17 +750,17 @@ func (w *Wrapper) Redraw() {
 			w.InfoBlockWidth,
 			0,
 			RowsAmount-w.InfoBlockWidth-DebugBlockHeight,
-			6,
+			ColumnsAmount,
 			0,
 			0,
 			false,
 		)
 		w.Grid.AddItem(
-			w.DebugTextView,
+			w.DebugBlock,
 			RowsAmount-DebugBlockHeight,
 			0,
 			DebugBlockHeight,
-			6,
+			ColumnsAmount,
 			0,
 			0,
 			false,
@@ -330,7 +771,7 @@ func (w *Wrapper) Redraw() {
 			w.InfoBlockWidth,
 			0,
 			RowsAmount-w.InfoBlockWidth,
-			6,
+			ColumnsAmount,
 			0,
 			0,
 			false,
@@ -343,5 +784,45 @@ func (w *Wrapper) Redraw() {
 		w.Pages.RemovePage("modal")
 	}
 
+	if w.IsSearchDisplayed {
+		w.Pages.AddPage("search", BottomAligned(w.SearchInput, 3), true, true)
+		w.App.SetFocus(w.SearchInput)
+		return
+	}
+
+	w.Pages.RemovePage("search")
+
+	if w.IsChainPickerDisplayed {
+		w.Pages.AddPage("chains", Centered(w.ChainPicker, 60, len(w.Chains)*2+2), true, true)
+		w.App.SetFocus(w.ChainPicker)
+		return
+	}
+
+	w.Pages.RemovePage("chains")
+
+	if w.IsValidatorInfoDisplayed {
+		w.Pages.AddPage("validator", Centered(w.ValidatorInfoTextView, 120, 30), true, true)
+		w.App.SetFocus(w.ValidatorInfoTextView)
+		return
+	}
+
+	w.Pages.RemovePage("validator")
 	w.App.SetFocus(table)
 }
+
+func BottomAligned(primitive tview.Primitive, height int) tview.Primitive {
+	return tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(nil, 0, 1, false).
+		AddItem(primitive, height, 1, true)
+}
+
+func Centered(primitive tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(primitive, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
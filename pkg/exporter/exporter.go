@@ -0,0 +1,240 @@
+package exporter
+
+import (
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/types"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog"
+)
+
+const MetricsPrefix = "tmtop_"
+
+type Exporter struct {
+	Logger        zerolog.Logger
+	ListenAddress string
+	Registry      *prometheus.Registry
+
+	state *types.State
+	mutex sync.Mutex
+
+	heightDesc               *prometheus.Desc
+	roundDesc                *prometheus.Desc
+	stepDesc                 *prometheus.Desc
+	consensusErrorDesc       *prometheus.Desc
+	prevotesPercentDesc      *prometheus.Desc
+	precommitsPercentDesc    *prometheus.Desc
+	validatorPrevoteDesc     *prometheus.Desc
+	validatorPrecommitDesc   *prometheus.Desc
+	validatorVotingPowerDesc *prometheus.Desc
+	validatorIsProposerDesc  *prometheus.Desc
+	blockTimeDesc            *prometheus.Desc
+	upgradeHeightDesc        *prometheus.Desc
+	blocksTillUpgradeDesc    *prometheus.Desc
+}
+
+func NewExporter(config *configPkg.Config, logger zerolog.Logger) *Exporter {
+	validatorLabels := []string{"address", "moniker", "index"}
+
+	exporter := &Exporter{
+		Logger:        logger.With().Str("component", "exporter").Logger(),
+		ListenAddress: config.ListenAddress,
+		Registry:      prometheus.NewRegistry(),
+
+		heightDesc: prometheus.NewDesc(
+			MetricsPrefix+"height",
+			"Current consensus height",
+			nil, nil,
+		),
+		roundDesc: prometheus.NewDesc(
+			MetricsPrefix+"round",
+			"Current consensus round",
+			nil, nil,
+		),
+		stepDesc: prometheus.NewDesc(
+			MetricsPrefix+"step",
+			"Current consensus step",
+			nil, nil,
+		),
+		consensusErrorDesc: prometheus.NewDesc(
+			MetricsPrefix+"consensus_error",
+			"Whether the last consensus state fetch failed",
+			nil, nil,
+		),
+		prevotesPercentDesc: prometheus.NewDesc(
+			MetricsPrefix+"prevotes_percent",
+			"Voting power percent that prevoted in the current round (total counts nil votes as well, agreeing only counts votes for a block)",
+			[]string{"type"}, nil,
+		),
+		precommitsPercentDesc: prometheus.NewDesc(
+			MetricsPrefix+"precommits_percent",
+			"Voting power percent that precommitted in the current round (total counts nil votes as well, agreeing only counts votes for a block)",
+			[]string{"type"}, nil,
+		),
+		validatorPrevoteDesc: prometheus.NewDesc(
+			MetricsPrefix+"validator_prevote",
+			"Validator prevote in the current round: 1 - voted for a block, 0 - voted for nil, -1 - not voted",
+			validatorLabels, nil,
+		),
+		validatorPrecommitDesc: prometheus.NewDesc(
+			MetricsPrefix+"validator_precommit",
+			"Validator precommit in the current round: 1 - voted for a block, 0 - voted for nil, -1 - not voted",
+			validatorLabels, nil,
+		),
+		validatorVotingPowerDesc: prometheus.NewDesc(
+			MetricsPrefix+"validator_voting_power_percent",
+			"Validator voting power percent",
+			validatorLabels, nil,
+		),
+		validatorIsProposerDesc: prometheus.NewDesc(
+			MetricsPrefix+"validator_is_proposer",
+			"Whether the validator is the proposer of the current round",
+			validatorLabels, nil,
+		),
+		blockTimeDesc: prometheus.NewDesc(
+			MetricsPrefix+"block_time_seconds",
+			"Average block time",
+			nil, nil,
+		),
+		upgradeHeightDesc: prometheus.NewDesc(
+			MetricsPrefix+"upgrade_height",
+			"Height of the scheduled chain upgrade",
+			[]string{"name"}, nil,
+		),
+		blocksTillUpgradeDesc: prometheus.NewDesc(
+			MetricsPrefix+"blocks_till_upgrade",
+			"Blocks left till the scheduled chain upgrade",
+			[]string{"name"}, nil,
+		),
+	}
+
+	exporter.Registry.MustRegister(exporter)
+	return exporter
+}
+
+func (e *Exporter) Start() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(e.Registry, promhttp.HandlerOpts{}))
+
+	e.Logger.Info().Str("address", e.ListenAddress).Msg("Listening")
+
+	if err := http.ListenAndServe(e.ListenAddress, mux); err != nil { //nolint:gosec // no timeouts needed here
+		e.Logger.Fatal().Err(err).Msg("Could not start exporter")
+	}
+}
+
+func (e *Exporter) Stop() {
+}
+
+func (e *Exporter) Alert(_ types.Alert) {
+}
+
+func (e *Exporter) SetState(state *types.State) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.state = state
+}
+
+func (e *Exporter) DebugText(text string) {
+	_, _ = fmt.Fprint(os.Stdout, text)
+}
+
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(e, ch)
+}
+
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.state == nil {
+		return
+	}
+
+	state := e.state
+	state.RLock()
+	defer state.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(e.consensusErrorDesc, prometheus.GaugeValue, boolToFloat(state.ConsensusStateError != nil))
+
+	if state.BlockTime != 0 {
+		ch <- prometheus.MustNewConstMetric(e.blockTimeDesc, prometheus.GaugeValue, state.BlockTime.Seconds())
+	}
+
+	if state.Upgrade != nil {
+		ch <- prometheus.MustNewConstMetric(e.upgradeHeightDesc, prometheus.GaugeValue, float64(state.Upgrade.Height), state.Upgrade.Name)
+
+		if state.Height > 0 {
+			ch <- prometheus.MustNewConstMetric(
+				e.blocksTillUpgradeDesc,
+				prometheus.GaugeValue,
+				float64(state.Upgrade.Height-state.Height),
+				state.Upgrade.Name,
+			)
+		}
+	}
+
+	if state.Validators == nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(e.heightDesc, prometheus.GaugeValue, float64(state.Height))
+	ch <- prometheus.MustNewConstMetric(e.roundDesc, prometheus.GaugeValue, float64(state.Round))
+	ch <- prometheus.MustNewConstMetric(e.stepDesc, prometheus.GaugeValue, float64(state.Step))
+
+	prevotesTotal, _ := state.Validators.GetTotalVotingPowerPrevotedPercent(true).Float64()
+	prevotesAgreeing, _ := state.Validators.GetTotalVotingPowerPrevotedPercent(false).Float64()
+	precommitsTotal, _ := state.Validators.GetTotalVotingPowerPrecommittedPercent(true).Float64()
+	precommitsAgreeing, _ := state.Validators.GetTotalVotingPowerPrecommittedPercent(false).Float64()
+
+	ch <- prometheus.MustNewConstMetric(e.prevotesPercentDesc, prometheus.GaugeValue, prevotesTotal, "total")
+	ch <- prometheus.MustNewConstMetric(e.prevotesPercentDesc, prometheus.GaugeValue, prevotesAgreeing, "agreeing")
+	ch <- prometheus.MustNewConstMetric(e.precommitsPercentDesc, prometheus.GaugeValue, precommitsTotal, "total")
+	ch <- prometheus.MustNewConstMetric(e.precommitsPercentDesc, prometheus.GaugeValue, precommitsAgreeing, "agreeing")
+
+	for _, validator := range state.GetValidatorsWithInfo() {
+		moniker := ""
+		if validator.ChainValidator != nil {
+			moniker = validator.ChainValidator.Moniker
+		}
+
+		labels := []string{
+			validator.Validator.Address,
+			moniker,
+			strconv.Itoa(validator.Validator.Index + 1),
+		}
+
+		votingPowerPercent, _ := validator.Validator.VotingPowerPercent.Float64()
+
+		ch <- prometheus.MustNewConstMetric(e.validatorPrevoteDesc, prometheus.GaugeValue, voteToFloat(validator.RoundVote.Prevote), labels...)
+		ch <- prometheus.MustNewConstMetric(e.validatorPrecommitDesc, prometheus.GaugeValue, voteToFloat(validator.RoundVote.Precommit), labels...)
+		ch <- prometheus.MustNewConstMetric(e.validatorVotingPowerDesc, prometheus.GaugeValue, votingPowerPercent, labels...)
+		ch <- prometheus.MustNewConstMetric(e.validatorIsProposerDesc, prometheus.GaugeValue, boolToFloat(validator.RoundVote.IsProposer), labels...)
+	}
+}
+
+func voteToFloat(vote types.Vote) float64 {
+	switch vote {
+	case types.Voted:
+		return 1
+	case types.VotedZero:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,268 @@
+package fetcher
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/types"
+	"time"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	providerTypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const GRPCTimeout = 60 * time.Second
+
+type CosmosGRPCDataFetcher struct {
+	Config *configPkg.Config
+	Logger zerolog.Logger
+
+	Connection              *grpc.ClientConn
+	ConnectionError         error
+	ProviderConnection      *grpc.ClientConn
+	ProviderConnectionError error
+
+	Registry   codecTypes.InterfaceRegistry
+	ParseCodec *codec.ProtoCodec
+}
+
+func NewCosmosGRPCDataFetcher(config *configPkg.Config, logger zerolog.Logger) *CosmosGRPCDataFetcher {
+	interfaceRegistry := codecTypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	parseCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	fetcherLogger := logger.With().Str("component", "cosmos_grpc_data_fetcher").Logger()
+
+	// Connections are established lazily on the first request, so this only fails
+	// if the host or the options are malformed.
+	connection, connectionErr := NewGRPCConnection(config.GRPCHost, config.GRPCTLS, parseCodec)
+	if connectionErr != nil {
+		fetcherLogger.Error().Err(connectionErr).Str("host", config.GRPCHost).Msg("Could not create gRPC connection")
+	}
+
+	fetcher := &CosmosGRPCDataFetcher{
+		Config:          config,
+		Logger:          fetcherLogger,
+		Connection:      connection,
+		ConnectionError: connectionErr,
+		Registry:        interfaceRegistry,
+		ParseCodec:      parseCodec,
+	}
+
+	if config.IsConsumer() {
+		providerConnection, providerConnectionErr := NewGRPCConnection(
+			config.ProviderGRPCHost,
+			config.ProviderGRPCTLS,
+			parseCodec,
+		)
+		if providerConnectionErr != nil {
+			fetcherLogger.Error().
+				Err(providerConnectionErr).
+				Str("host", config.ProviderGRPCHost).
+				Msg("Could not create provider gRPC connection")
+		}
+
+		fetcher.ProviderConnection = providerConnection
+		fetcher.ProviderConnectionError = providerConnectionErr
+	}
+
+	return fetcher
+}
+
+func NewGRPCConnection(host string, useTLS bool, parseCodec *codec.ProtoCodec) (*grpc.ClientConn, error) {
+	transportCredentials := insecure.NewCredentials()
+	if useTLS {
+		transportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	return grpc.NewClient(
+		host,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(parseCodec.GRPCCodec())),
+	)
+}
+
+// Close closes the gRPC connections, requests that are still running would fail.
+func (f *CosmosGRPCDataFetcher) Close() error {
+	var err error
+
+	if f.Connection != nil {
+		err = f.Connection.Close()
+	}
+
+	if f.ProviderConnection != nil {
+		if providerErr := f.ProviderConnection.Close(); providerErr != nil {
+			err = providerErr
+		}
+	}
+
+	return err
+}
+
+func (f *CosmosGRPCDataFetcher) GetProviderOrConsumerConnection() (*grpc.ClientConn, error) {
+	if f.Config.IsConsumer() {
+		return f.ProviderConnection, f.ProviderConnectionError
+	}
+
+	return f.Connection, f.ConnectionError
+}
+
+func (f *CosmosGRPCDataFetcher) GetValidators() (*types.ChainValidators, error) {
+	connection, err := f.GetProviderOrConsumerConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), GRPCTimeout)
+	defer cancel()
+
+	stakingValidators, err := FetchAllPages(
+		"validators",
+		f.Logger,
+		func(key []byte) ([]stakingTypes.Validator, *queryTypes.PageResponse, error) {
+			validatorsResponse, err := stakingTypes.NewQueryClient(connection).Validators(
+				ctx,
+				&stakingTypes.QueryValidatorsRequest{
+					Pagination: &queryTypes.PageRequest{
+						Key:   key,
+						Limit: PageLimit,
+					},
+				},
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return validatorsResponse.Validators, validatorsResponse.Pagination, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make(types.ChainValidators, len(stakingValidators))
+
+	for index, validator := range stakingValidators {
+		chainValidator, err := ParseStakingValidator(validator, f.ParseCodec)
+		if err != nil {
+			return nil, err
+		}
+
+		validators[index] = chainValidator
+	}
+
+	if f.Config.IsConsumer() {
+		pairs, err := f.GetConsumerKeyPairs()
+		if err != nil {
+			return nil, err
+		}
+
+		AssignConsumerAddresses(validators, pairs, f.Logger)
+	}
+
+	if signingInfos, params, err := f.GetSigningInfos(); err != nil {
+		f.Logger.Warn().Err(err).Msg("Could not fetch signing infos")
+	} else {
+		AssignSigningInfos(validators, signingInfos, params, f.Logger)
+	}
+
+	return &validators, nil
+}
+
+// GetConsumerKeyPairs fetches the provider/consumer address pairs of the consumer chain.
+func (f *CosmosGRPCDataFetcher) GetConsumerKeyPairs() ([]*providerTypes.PairValConAddrProviderAndConsumer, error) {
+	if f.ProviderConnectionError != nil {
+		return nil, f.ProviderConnectionError
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), GRPCTimeout)
+	defer cancel()
+
+	assignedKeysResponse, err := providerTypes.NewQueryClient(f.ProviderConnection).QueryAllPairsValConsAddrByConsumer(
+		ctx,
+		&providerTypes.QueryAllPairsValConsAddrByConsumerRequest{
+			ConsumerId: f.Config.ConsumerID,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching consumer key assignments: %w", err)
+	}
+
+	return assignedKeysResponse.PairValConAddr, nil
+}
+
+func (f *CosmosGRPCDataFetcher) GetSigningInfos() ([]slashingTypes.ValidatorSigningInfo, slashingTypes.Params, error) {
+	if f.ConnectionError != nil {
+		return nil, slashingTypes.Params{}, f.ConnectionError
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), GRPCTimeout)
+	defer cancel()
+
+	client := slashingTypes.NewQueryClient(f.Connection)
+
+	paramsResponse, err := client.Params(ctx, &slashingTypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	signingInfos, err := FetchAllPages(
+		"signing infos",
+		f.Logger,
+		func(key []byte) ([]slashingTypes.ValidatorSigningInfo, *queryTypes.PageResponse, error) {
+			signingInfosResponse, err := client.SigningInfos(ctx, &slashingTypes.QuerySigningInfosRequest{
+				Pagination: &queryTypes.PageRequest{
+					Key:   key,
+					Limit: PageLimit,
+				},
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return signingInfosResponse.Info, signingInfosResponse.Pagination, nil
+		},
+	)
+	if err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	return signingInfos, paramsResponse.Params, nil
+}
+
+func (f *CosmosGRPCDataFetcher) GetUpgradePlan() (*types.Upgrade, error) {
+	if f.ConnectionError != nil {
+		return nil, f.ConnectionError
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), GRPCTimeout)
+	defer cancel()
+
+	response, err := upgradeTypes.NewQueryClient(f.Connection).CurrentPlan(
+		ctx,
+		&upgradeTypes.QueryCurrentPlanRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Plan == nil {
+		return nil, nil
+	}
+
+	return &types.Upgrade{
+		Name:   response.Plan.Name,
+		Height: response.Plan.Height,
+	}, nil
+}
@@ -5,21 +5,24 @@ import (
 	configPkg "main/pkg/config"
 	"main/pkg/http"
 	"main/pkg/types"
-
-	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"net/url"
 
 	upgradeTypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
+	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	providerTypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	"github.com/rs/zerolog"
 )
 
 type CosmosLcdDataFetcher struct {
-	Config *configPkg.Config
-	Logger zerolog.Logger
-	Client *http.Client
+	Config         *configPkg.Config
+	Logger         zerolog.Logger
+	Client         *http.Client
+	ProviderClient *http.Client
 
 	Registry    codecTypes.InterfaceRegistry
 	ParseCodec  *codec.ProtoCodec
@@ -32,51 +35,128 @@ func NewCosmosLcdDataFetcher(config *configPkg.Config, logger zerolog.Logger) *C
 	parseCodec := codec.NewProtoCodec(interfaceRegistry)
 
 	return &CosmosLcdDataFetcher{
-		Config:     config,
-		Logger:     logger.With().Str("component", "cosmos_lcd_data_fetcher").Logger(),
-		Client:     http.NewClient(logger, "cosmos_lcd_data_fetcher", config.LCDHost),
-		Registry:   interfaceRegistry,
-		ParseCodec: parseCodec,
+		Config:         config,
+		Logger:         logger.With().Str("component", "cosmos_lcd_data_fetcher").Logger(),
+		Client:         http.NewClient(logger, "cosmos_lcd_data_fetcher", config.FailoverTimeout, config.LCDHost),
+		ProviderClient: http.NewClient(logger, "cosmos_lcd_data_fetcher", config.FailoverTimeout, config.ProviderLCDHost),
+		Registry:       interfaceRegistry,
+		ParseCodec:     parseCodec,
 	}
 }
 
-func (f *CosmosLcdDataFetcher) GetValidators() (*types.ChainValidators, error) {
-	bytes, err := f.Client.GetPlain(
-		"/cosmos/staking/v1beta1/validators?status=BOND_STATUS_BONDED&pagination.limit=1000",
-	)
-
-	if err != nil {
-		return nil, err
+func (f *CosmosLcdDataFetcher) GetProviderOrConsumerClient() *http.Client {
+	if f.Config.IsConsumer() {
+		return f.ProviderClient
 	}
 
-	var validatorsResponse stakingTypes.QueryValidatorsResponse
+	return f.Client
+}
 
-	if err := f.ParseCodec.UnmarshalJSON(bytes, &validatorsResponse); err != nil {
+func (f *CosmosLcdDataFetcher) GetValidators() (*types.ChainValidators, error) {
+	stakingValidators, err := FetchAllPages(
+		"validators",
+		f.Logger,
+		func(key []byte) ([]stakingTypes.Validator, *queryTypes.PageResponse, error) {
+			bytes, err := f.GetProviderOrConsumerClient().GetPlain(
+				"/cosmos/staking/v1beta1/validators?" + LCDPaginationQuery(key),
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var validatorsResponse stakingTypes.QueryValidatorsResponse
+			if err := f.ParseCodec.UnmarshalJSON(bytes, &validatorsResponse); err != nil {
+				return nil, nil, err
+			}
+
+			return validatorsResponse.Validators, validatorsResponse.Pagination, nil
+		},
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	validators := make(types.ChainValidators, len(validatorsResponse.Validators))
+	validators := make(types.ChainValidators, len(stakingValidators))
 
-	for index, validator := range validatorsResponse.Validators {
-		if err := validator.UnpackInterfaces(f.ParseCodec); err != nil {
+	for index, validator := range stakingValidators {
+		chainValidator, err := ParseStakingValidator(validator, f.ParseCodec)
+		if err != nil {
 			return nil, err
 		}
 
-		addr, err := validator.GetConsAddr()
+		validators[index] = chainValidator
+	}
+
+	if f.Config.IsConsumer() {
+		pairs, err := f.GetConsumerKeyPairs()
 		if err != nil {
 			return nil, err
 		}
 
-		validators[index] = types.ChainValidator{
-			Moniker:    validator.Description.Moniker,
-			Address:    fmt.Sprintf("%X", addr),
-			RawAddress: sdkTypes.ConsAddress(addr).String(),
-		}
+		AssignConsumerAddresses(validators, pairs, f.Logger)
+	}
+
+	if signingInfos, params, err := f.GetSigningInfos(); err != nil {
+		f.Logger.Warn().Err(err).Msg("Could not fetch signing infos")
+	} else {
+		AssignSigningInfos(validators, signingInfos, params, f.Logger)
 	}
 
 	return &validators, nil
 }
 
+// GetConsumerKeyPairs fetches the provider/consumer address pairs of the consumer chain.
+func (f *CosmosLcdDataFetcher) GetConsumerKeyPairs() ([]*providerTypes.PairValConAddrProviderAndConsumer, error) {
+	bytes, err := f.ProviderClient.GetPlain(
+		"/interchain_security/ccv/provider/address_pairs/" + url.PathEscape(f.Config.ConsumerID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching consumer key assignments: %w", err)
+	}
+
+	var assignedKeysResponse providerTypes.QueryAllPairsValConsAddrByConsumerResponse
+	if err := f.ParseCodec.UnmarshalJSON(bytes, &assignedKeysResponse); err != nil {
+		return nil, err
+	}
+
+	return assignedKeysResponse.PairValConAddr, nil
+}
+
+func (f *CosmosLcdDataFetcher) GetSigningInfos() ([]slashingTypes.ValidatorSigningInfo, slashingTypes.Params, error) {
+	paramsBytes, err := f.Client.GetPlain("/cosmos/slashing/v1beta1/params")
+	if err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	var paramsResponse slashingTypes.QueryParamsResponse
+	if err := f.ParseCodec.UnmarshalJSON(paramsBytes, &paramsResponse); err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	signingInfos, err := FetchAllPages(
+		"signing infos",
+		f.Logger,
+		func(key []byte) ([]slashingTypes.ValidatorSigningInfo, *queryTypes.PageResponse, error) {
+			bytes, err := f.Client.GetPlain("/cosmos/slashing/v1beta1/signing_infos?" + LCDPaginationQuery(key))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var signingInfosResponse slashingTypes.QuerySigningInfosResponse
+			if err := f.ParseCodec.UnmarshalJSON(bytes, &signingInfosResponse); err != nil {
+				return nil, nil, err
+			}
+
+			return signingInfosResponse.Info, signingInfosResponse.Pagination, nil
+		},
+	)
+	if err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	return signingInfos, paramsResponse.Params, nil
+}
+
 func (f *CosmosLcdDataFetcher) GetUpgradePlan() (*types.Upgrade, error) {
 	var response upgradeTypes.QueryCurrentPlanResponse
 	if err := f.Client.Get(
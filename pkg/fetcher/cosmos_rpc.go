@@ -7,7 +7,6 @@ import (
 	configPkg "main/pkg/config"
 	"main/pkg/http"
 	"main/pkg/types"
-	"main/pkg/utils"
 	"net/url"
 	"strconv"
 	"strings"
@@ -25,6 +24,7 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptoTypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	providerTypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 )
@@ -50,16 +50,24 @@ func NewCosmosRPCDataFetcher(config *configPkg.Config, logger zerolog.Logger) *C
 	return &CosmosRPCDataFetcher{
 		Config:         config,
 		Logger:         logger.With().Str("component", "cosmos_data_fetcher").Logger(),
-		ProviderClient: http.NewClient(logger, "cosmos_data_fetcher", config.ProviderRPCHost),
-		Client:         http.NewClient(logger, "cosmos_data_fetcher", config.RPCHost),
+		ProviderClient: http.NewClient(logger, "cosmos_data_fetcher", config.FailoverTimeout, config.ProviderRPCHosts...),
+		Client:         http.NewClient(logger, "cosmos_data_fetcher", config.FailoverTimeout, config.RPCHosts...),
 		Registry:       interfaceRegistry,
 		ParseCodec:     parseCodec,
 		TxDecoder:      txDecoder.TxJSONDecoder(),
 	}
 }
 
+func (f *CosmosRPCDataFetcher) CheckHealth() {
+	f.Client.CheckHealth("/health")
+
+	if f.Config.IsConsumer() {
+		f.ProviderClient.CheckHealth("/health")
+	}
+}
+
 func (f *CosmosRPCDataFetcher) GetProviderOrConsumerClient() *http.Client {
-	if f.Config.ProviderRPCHost != "" {
+	if f.Config.IsConsumer() {
 		return f.ProviderClient
 	}
 
@@ -101,45 +109,44 @@ func (f *CosmosRPCDataFetcher) AbciQuery(
 }
 
 func (f *CosmosRPCDataFetcher) ParseValidator(validator stakingTypes.Validator) (types.ChainValidator, error) {
-	if err := validator.UnpackInterfaces(f.ParseCodec); err != nil {
-		return types.ChainValidator{}, err
-	}
-
-	addr, err := validator.GetConsAddr()
-	if err != nil {
-		return types.ChainValidator{}, err
-	}
-
-	return types.ChainValidator{
-		Moniker:    validator.GetMoniker(),
-		Address:    fmt.Sprintf("%X", addr),
-		RawAddress: sdkTypes.ConsAddress(addr).String(),
-	}, nil
+	return ParseStakingValidator(validator, f.ParseCodec)
 }
 
 func (f *CosmosRPCDataFetcher) GetValidators() (*types.ChainValidators, error) {
-	query := stakingTypes.QueryValidatorsRequest{
-		Pagination: &queryTypes.PageRequest{
-			Limit: 1000,
-		},
-	}
+	stakingValidators, err := FetchAllPages(
+		"validators",
+		f.Logger,
+		func(key []byte) ([]stakingTypes.Validator, *queryTypes.PageResponse, error) {
+			query := stakingTypes.QueryValidatorsRequest{
+				Pagination: &queryTypes.PageRequest{
+					Key:   key,
+					Limit: PageLimit,
+				},
+			}
 
-	var validatorsResponse stakingTypes.QueryValidatorsResponse
-	if err := f.AbciQuery(
-		"/cosmos.staking.v1beta1.Query/Validators",
-		&query,
-		&validatorsResponse,
-		f.GetProviderOrConsumerClient(),
-	); err != nil {
+			var validatorsResponse stakingTypes.QueryValidatorsResponse
+			if err := f.AbciQuery(
+				"/cosmos.staking.v1beta1.Query/Validators",
+				&query,
+				&validatorsResponse,
+				f.GetProviderOrConsumerClient(),
+			); err != nil {
+				return nil, nil, err
+			}
+
+			return validatorsResponse.Validators, validatorsResponse.Pagination, nil
+		},
+	)
+	if err != nil {
 		if strings.Contains(err.Error(), " please wait for first block") {
 			return f.GetGenesisValidators()
 		}
 		return nil, err
 	}
 
-	validators := make(types.ChainValidators, len(validatorsResponse.Validators))
+	validators := make(types.ChainValidators, len(stakingValidators))
 
-	for index, validator := range validatorsResponse.Validators {
+	for index, validator := range stakingValidators {
 		if chainValidator, err := f.ParseValidator(validator); err != nil {
 			return nil, err
 		} else {
@@ -147,10 +154,26 @@ func (f *CosmosRPCDataFetcher) GetValidators() (*types.ChainValidators, error) {
 		}
 	}
 
-	if !f.Config.IsConsumer() {
-		return &validators, nil
+	if f.Config.IsConsumer() {
+		pairs, err := f.GetConsumerKeyPairs()
+		if err != nil {
+			return nil, err
+		}
+
+		AssignConsumerAddresses(validators, pairs, f.Logger)
+	}
+
+	if signingInfos, params, err := f.GetSigningInfos(); err != nil {
+		f.Logger.Warn().Err(err).Msg("Could not fetch signing infos")
+	} else {
+		AssignSigningInfos(validators, signingInfos, params, f.Logger)
 	}
 
+	return &validators, nil
+}
+
+// GetConsumerKeyPairs fetches the provider/consumer address pairs of the consumer chain.
+func (f *CosmosRPCDataFetcher) GetConsumerKeyPairs() ([]*providerTypes.PairValConAddrProviderAndConsumer, error) {
 	assignedKeysQuery := providerTypes.QueryAllPairsValConsAddrByConsumerRequest{
 		ConsumerId: f.Config.ConsumerID,
 	}
@@ -162,36 +185,52 @@ func (f *CosmosRPCDataFetcher) GetValidators() (*types.ChainValidators, error) {
 		&assignedKeysResponse,
 		f.ProviderClient,
 	); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error fetching consumer key assignments: %w", err)
 	}
 
-	for index, validator := range validators {
-		assignedConsensusAddr, ok := utils.Find(
-			assignedKeysResponse.PairValConAddr,
-			func(i *providerTypes.PairValConAddrProviderAndConsumer) bool {
-				equal, compareErr := utils.CompareTwoBech32(i.ProviderAddress, validator.RawAddress)
-				if compareErr != nil {
-					f.Logger.Error().
-						Str("operator_address", validator.Address).
-						Str("first", i.ProviderAddress).
-						Str("second", validator.RawAddress).
-						Msg("Error converting bech32 address")
-					return false
-				}
-
-				return equal
-			},
-		)
+	return assignedKeysResponse.PairValConAddr, nil
+}
+
+func (f *CosmosRPCDataFetcher) GetSigningInfos() ([]slashingTypes.ValidatorSigningInfo, slashingTypes.Params, error) {
+	var paramsResponse slashingTypes.QueryParamsResponse
+	if err := f.AbciQuery(
+		"/cosmos.slashing.v1beta1.Query/Params",
+		&slashingTypes.QueryParamsRequest{},
+		&paramsResponse,
+		f.Client,
+	); err != nil {
+		return nil, slashingTypes.Params{}, err
+	}
+
+	signingInfos, err := FetchAllPages(
+		"signing infos",
+		f.Logger,
+		func(key []byte) ([]slashingTypes.ValidatorSigningInfo, *queryTypes.PageResponse, error) {
+			query := slashingTypes.QuerySigningInfosRequest{
+				Pagination: &queryTypes.PageRequest{
+					Key:   key,
+					Limit: PageLimit,
+				},
+			}
 
-		if ok {
-			addr, _ := sdkTypes.ConsAddressFromBech32(assignedConsensusAddr.ConsumerAddress)
+			var signingInfosResponse slashingTypes.QuerySigningInfosResponse
+			if err := f.AbciQuery(
+				"/cosmos.slashing.v1beta1.Query/SigningInfos",
+				&query,
+				&signingInfosResponse,
+				f.Client,
+			); err != nil {
+				return nil, nil, err
+			}
 
-			validators[index].AssignedAddress = addr.String()
-			validators[index].RawAssignedAddress = fmt.Sprintf("%X", addr)
-		}
+			return signingInfosResponse.Info, signingInfosResponse.Pagination, nil
+		},
+	)
+	if err != nil {
+		return nil, slashingTypes.Params{}, err
 	}
 
-	return &validators, nil
+	return signingInfos, paramsResponse.Params, nil
 }
 
 func (f *CosmosRPCDataFetcher) GetGenesisValidators() (*types.ChainValidators, error) {
@@ -289,9 +328,16 @@ func (f *CosmosRPCDataFetcher) GetGenesisValidators() (*types.ChainValidators, e
 		addr := sdkTypes.ConsAddress(pubkey.Address())
 
 		validators[index] = types.ChainValidator{
-			Moniker:    msgCreateValidator.Description.Moniker,
-			Address:    fmt.Sprintf("%X", addr),
-			RawAddress: addr.String(),
+			Moniker:         msgCreateValidator.Description.Moniker,
+			Address:         fmt.Sprintf("%X", addr),
+			RawAddress:      addr.String(),
+			OperatorAddress: msgCreateValidator.ValidatorAddress,
+			Status:          types.ValidatorStatusUnknown,
+			Commission:      legacyDecToFloat(msgCreateValidator.Commission.Rate),
+		}
+
+		if !msgCreateValidator.Value.Amount.IsNil() {
+			validators[index].Tokens = msgCreateValidator.Value.Amount.BigInt()
 		}
 	}
 
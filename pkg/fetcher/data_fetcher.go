@@ -1,17 +1,44 @@
 package fetcher
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
 	configPkg "main/pkg/config"
 	"main/pkg/types"
+	"main/pkg/utils"
+	"net/url"
 
+	"cosmossdk.io/math"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	providerTypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	"github.com/rs/zerolog"
 )
 
+// PageLimit is the amount of items requested per page for paginated queries.
+const PageLimit = 1000
+
 type DataFetcher interface {
 	GetValidators() (*types.ChainValidators, error)
 	GetUpgradePlan() (*types.Upgrade, error)
 }
 
+// HealthChecker is implemented by data fetchers that can check their hosts' health.
+type HealthChecker interface {
+	CheckHealth()
+}
+
+// Closer is implemented by data fetchers that hold connections which should be closed
+// once the fetcher is not needed anymore.
+type Closer interface {
+	Close() error
+}
+
 func GetDataFetcher(config *configPkg.Config, logger zerolog.Logger) DataFetcher {
 	if config.ChainType == "tendermint" {
 		return NewNoopDataFetcher()
@@ -21,5 +48,191 @@ func GetDataFetcher(config *configPkg.Config, logger zerolog.Logger) DataFetcher
 		return NewCosmosLcdDataFetcher(config, logger)
 	}
 
+	if config.ChainType == "cosmos-grpc" {
+		return NewCosmosGRPCDataFetcher(config, logger)
+	}
+
 	return NewCosmosRPCDataFetcher(config, logger)
 }
+
+// FetchAllPages calls fetchPage with the next key from the previous page until there are
+// no pages left, and returns the items from all pages.
+func FetchAllPages[T any](
+	name string,
+	logger zerolog.Logger,
+	fetchPage func(key []byte) ([]T, *queryTypes.PageResponse, error),
+) ([]T, error) {
+	items := make([]T, 0)
+	var key []byte
+
+	for page := 1; ; page++ {
+		pageItems, pagination, err := fetchPage(key)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching %s, page %d: %w", name, page, err)
+		}
+
+		items = append(items, pageItems...)
+
+		logger.Debug().
+			Str("query", name).
+			Int("page", page).
+			Int("count", len(pageItems)).
+			Msg("Fetched page")
+
+		if pagination == nil || len(pagination.NextKey) == 0 {
+			return items, nil
+		}
+
+		// A node returning the same key would make us fetch the same page forever.
+		if bytes.Equal(pagination.NextKey, key) {
+			return nil, fmt.Errorf("error fetching %s, page %d: got the same next key as before", name, page)
+		}
+
+		key = pagination.NextKey
+	}
+}
+
+// LCDPaginationQuery returns the LCD query params for requesting a page starting with the key.
+func LCDPaginationQuery(key []byte) string {
+	query := fmt.Sprintf("pagination.limit=%d", PageLimit)
+	if len(key) > 0 {
+		query += "&pagination.key=" + url.QueryEscape(base64.StdEncoding.EncodeToString(key))
+	}
+
+	return query
+}
+
+// ParseStakingValidator converts the staking module validator to the one used in the app.
+func ParseStakingValidator(validator stakingTypes.Validator, unpacker codecTypes.AnyUnpacker) (types.ChainValidator, error) {
+	if err := validator.UnpackInterfaces(unpacker); err != nil {
+		return types.ChainValidator{}, err
+	}
+
+	addr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.ChainValidator{}, err
+	}
+
+	chainValidator := types.ChainValidator{
+		Moniker:         validator.GetMoniker(),
+		Address:         fmt.Sprintf("%X", addr),
+		RawAddress:      sdkTypes.ConsAddress(addr).String(),
+		OperatorAddress: validator.OperatorAddress,
+		Status:          ParseValidatorStatus(validator.Status),
+		DelegatorShares: legacyDecToFloat(validator.DelegatorShares),
+		Commission:      legacyDecToFloat(validator.Commission.CommissionRates.Rate),
+		Jailed:          validator.Jailed,
+	}
+
+	if !validator.Tokens.IsNil() {
+		chainValidator.Tokens = validator.Tokens.BigInt()
+	}
+
+	return chainValidator, nil
+}
+
+func ParseValidatorStatus(status stakingTypes.BondStatus) types.ValidatorStatus {
+	switch status {
+	case stakingTypes.Bonded:
+		return types.ValidatorStatusBonded
+	case stakingTypes.Unbonding:
+		return types.ValidatorStatusUnbonding
+	case stakingTypes.Unbonded:
+		return types.ValidatorStatusUnbonded
+	default:
+		return types.ValidatorStatusUnknown
+	}
+}
+
+func legacyDecToFloat(value math.LegacyDec) float64 {
+	if value.IsNil() {
+		return 0
+	}
+
+	result, _ := value.Float64()
+	return result
+}
+
+// AssignConsumerAddresses sets the consumer chain addresses for validators that have assigned
+// a consumer key, based on the provider/consumer address pairs from the provider chain.
+// The provider returns all key assignments at once, as this query has no pagination.
+func AssignConsumerAddresses(
+	validators types.ChainValidators,
+	pairs []*providerTypes.PairValConAddrProviderAndConsumer,
+	logger zerolog.Logger,
+) {
+	for index, validator := range validators {
+		assignedConsensusAddr, ok := utils.Find(
+			pairs,
+			func(i *providerTypes.PairValConAddrProviderAndConsumer) bool {
+				equal, compareErr := utils.CompareTwoBech32(i.ProviderAddress, validator.RawAddress)
+				if compareErr != nil {
+					logger.Error().
+						Str("operator_address", validator.Address).
+						Str("first", i.ProviderAddress).
+						Str("second", validator.RawAddress).
+						Msg("Error converting bech32 address")
+					return false
+				}
+
+				return equal
+			},
+		)
+
+		if ok {
+			addr, _ := sdkTypes.ConsAddressFromBech32(assignedConsensusAddr.ConsumerAddress)
+
+			validators[index].AssignedAddress = addr.String()
+			validators[index].RawAssignedAddress = fmt.Sprintf("%X", addr)
+		}
+	}
+}
+
+// AssignSigningInfos sets the slashing signing info for each validator. On consumer chains
+// signing infos are stored by the assigned consumer key, if there's one.
+func AssignSigningInfos(
+	validators types.ChainValidators,
+	signingInfos []slashingTypes.ValidatorSigningInfo,
+	params slashingTypes.Params,
+	logger zerolog.Logger,
+) {
+	minSignedPerWindow, err := params.MinSignedPerWindow.Float64()
+	if err != nil {
+		logger.Error().Err(err).Msg("Error parsing min signed per window")
+		return
+	}
+
+	signingInfosMap := make(map[string]slashingTypes.ValidatorSigningInfo, len(signingInfos))
+	for _, signingInfo := range signingInfos {
+		_, addressBytes, err := bech32.DecodeAndConvert(signingInfo.Address)
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Str("address", signingInfo.Address).
+				Msg("Error converting signing info address")
+			continue
+		}
+
+		signingInfosMap[fmt.Sprintf("%X", addressBytes)] = signingInfo
+	}
+
+	for index, validator := range validators {
+		address := validator.Address
+		if validator.RawAssignedAddress != "" {
+			address = validator.RawAssignedAddress
+		}
+
+		signingInfo, ok := signingInfosMap[address]
+		if !ok {
+			continue
+		}
+
+		validators[index].SigningInfo = &types.SigningInfo{
+			MissedBlocks:       signingInfo.MissedBlocksCounter,
+			SignedBlocksWindow: params.SignedBlocksWindow,
+			MinSignedPerWindow: minSignedPerWindow,
+			JailedUntil:        signingInfo.JailedUntil,
+			Tombstoned:         signingInfo.Tombstoned,
+		}
+	}
+}
@@ -4,41 +4,193 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"main/pkg/types"
+	"net"
 	"net/http"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 )
 
+const (
+	// LatencySmoothing is the weight of the latest measurement in the host latency moving average.
+	LatencySmoothing = 0.3
+	// DefaultTimeout is how long to wait for a host when there's no other host to fail over to.
+	DefaultTimeout = 300 * time.Second
+)
+
+type Host struct {
+	URL       string
+	Healthy   bool
+	Latency   time.Duration
+	LastError error
+}
+
 type Client struct {
 	Logger zerolog.Logger
-	Host   string
+	Hosts  []*Host
+	Active *Host
+
+	// FailoverTimeout is how long to wait for a host before failing over to the next one,
+	// it's also used for health checks.
+	FailoverTimeout time.Duration
+
+	mutex sync.Mutex
 }
 
-func NewClient(logger zerolog.Logger, invoker, host string) *Client {
-	return &Client{
+func NewClient(
+	logger zerolog.Logger,
+	invoker string,
+	failoverTimeout time.Duration,
+	hosts ...string,
+) *Client {
+	clientHosts := make([]*Host, len(hosts))
+	for index, host := range hosts {
+		clientHosts[index] = &Host{URL: host, Healthy: true}
+	}
+
+	client := &Client{
 		Logger: logger.With().
 			Str("component", "http").
 			Str("invoker", invoker).
 			Logger(),
-		Host: host,
+		Hosts:           clientHosts,
+		FailoverTimeout: failoverTimeout,
+	}
+
+	if len(clientHosts) > 0 {
+		client.Active = clientHosts[0]
+	}
+
+	return client
+}
+
+func (c *Client) GetActiveHost() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.Active == nil {
+		return ""
+	}
+
+	return c.Active.URL
+}
+
+// GetHostsToTry returns the hosts in the order they should be queried: the active host first
+// if it's healthy (so we stick to it as long as it works), then other healthy hosts
+// from the fastest to the slowest, then unhealthy ones, so they can recover.
+func (c *Client) GetHostsToTry() []*Host {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	hosts := make([]*Host, len(c.Hosts))
+	copy(hosts, c.Hosts)
+
+	sort.SliceStable(hosts, func(i, j int) bool {
+		first, second := hosts[i], hosts[j]
+
+		if first.Healthy != second.Healthy {
+			return first.Healthy
+		}
+
+		if first == c.Active || second == c.Active {
+			return first == c.Active
+		}
+
+		return first.Latency < second.Latency
+	})
+
+	return hosts
+}
+
+func (c *Client) ReportResult(host *Host, latency time.Duration, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	host.LastError = err
+	host.Healthy = err == nil
+
+	if err != nil {
+		return
+	}
+
+	if host.Latency == 0 {
+		host.Latency = latency
+	} else {
+		host.Latency = time.Duration(
+			float64(host.Latency)*(1-LatencySmoothing) + float64(latency)*LatencySmoothing,
+		)
+	}
+
+	if c.Active != host {
+		c.Logger.Warn().
+			Str("host", host.URL).
+			Msg("Switched to another host")
+		c.Active = host
 	}
 }
 
 func (c *Client) GetInternal(relativeURL string) (io.ReadCloser, error) {
+	if len(c.Hosts) == 0 {
+		return nil, fmt.Errorf("no hosts configured")
+	}
+
+	var lastErr error
+
+	hosts := c.GetHostsToTry()
+
+	for index, host := range hosts {
+		// A host that does not respond shouldn't block failing over to the next one for long,
+		// but the last one is waited for as long as needed, as there's nothing to fail over to.
+		timeout := DefaultTimeout
+		if index < len(hosts)-1 {
+			timeout = c.GetFailoverTimeout()
+		}
+
+		start := time.Now()
+		body, err := c.GetFromHost(host.URL, relativeURL, timeout)
+		c.ReportResult(host, time.Since(start), err)
+
+		if err == nil {
+			return body, nil
+		}
+
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func (c *Client) GetFailoverTimeout() time.Duration {
+	if c.FailoverTimeout <= 0 {
+		return DefaultTimeout
+	}
+
+	return c.FailoverTimeout
+}
+
+func (c *Client) GetFromHost(host, relativeURL string, timeout time.Duration) (io.ReadCloser, error) {
 	var transport http.RoundTripper
 
+	// The timeout only limits connecting and waiting for the response headers, as reading
+	// a big response, like the consensus state dump, on a slow link can take way longer.
 	transportRaw, ok := http.DefaultTransport.(*http.Transport)
 	if ok {
-		transport = transportRaw.Clone()
+		cloned := transportRaw.Clone()
+		cloned.DialContext = (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext
+		cloned.TLSHandshakeTimeout = timeout
+		cloned.ResponseHeaderTimeout = timeout
+		transport = cloned
 	} else {
 		transport = http.DefaultTransport
 	}
 
-	client := &http.Client{Timeout: 300 * time.Second, Transport: transport}
+	client := &http.Client{Timeout: DefaultTimeout, Transport: transport}
 	start := time.Now()
 
-	fullURL := fmt.Sprintf("%s%s", c.Host, relativeURL)
+	fullURL := fmt.Sprintf("%s%s", host, relativeURL)
 
 	req, err := http.NewRequest(http.MethodGet, fullURL, nil)
 	if err != nil {
@@ -55,11 +207,83 @@ func (c *Client) GetInternal(relativeURL string) (io.ReadCloser, error) {
 		return nil, err
 	}
 
+	// Node errors are returned with 500 and are parsed by callers,
+	// but gateway errors mean the host is not reachable.
+	if res.StatusCode == http.StatusBadGateway ||
+		res.StatusCode == http.StatusServiceUnavailable ||
+		res.StatusCode == http.StatusGatewayTimeout {
+		_ = res.Body.Close()
+		c.Logger.Warn().Str("url", fullURL).Int("status", res.StatusCode).Msg("Query failed")
+		return nil, fmt.Errorf("got bad status code from %s: %d", host, res.StatusCode)
+	}
+
 	c.Logger.Debug().Str("url", fullURL).Dur("duration", time.Since(start)).Msg("Query is finished")
 
 	return res.Body, nil
 }
 
+// CheckHealth queries every host, updating its health and latency.
+func (c *Client) CheckHealth(relativeURL string) {
+	var wg sync.WaitGroup
+
+	for _, host := range c.Hosts {
+		wg.Add(1)
+
+		go func(host *Host) {
+			defer wg.Done()
+
+			start := time.Now()
+			body, err := c.GetFromHost(host.URL, relativeURL, c.GetFailoverTimeout())
+			latency := time.Since(start)
+
+			if err == nil {
+				err = body.Close()
+			}
+
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			host.LastError = err
+			host.Healthy = err == nil
+
+			if err == nil {
+				host.Latency = latency
+			}
+		}(host)
+	}
+
+	wg.Wait()
+
+	// Switching from the active host only if it's down, otherwise sticking to it.
+	if hosts := c.GetHostsToTry(); len(hosts) > 0 && hosts[0].Healthy {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.Active != hosts[0] {
+			c.Logger.Warn().Str("host", hosts[0].URL).Msg("Switched to another host")
+			c.Active = hosts[0]
+		}
+	}
+}
+
+func (c *Client) GetEndpoints() types.RPCEndpoints {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	endpoints := make(types.RPCEndpoints, len(c.Hosts))
+	for index, host := range c.Hosts {
+		endpoints[index] = types.RPCEndpoint{
+			URL:     host.URL,
+			Active:  host == c.Active,
+			Healthy: host.Healthy,
+			Latency: host.Latency,
+			Error:   host.LastError,
+		}
+	}
+
+	return endpoints
+}
+
 func (c *Client) Get(relativeURL string, target interface{}) error {
 	body, err := c.GetInternal(relativeURL)
 	if err != nil {
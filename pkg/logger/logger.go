@@ -2,7 +2,6 @@ package logger
 
 import (
 	"io"
-	configPkg "main/pkg/config"
 	"os"
 
 	"github.com/rs/zerolog"
@@ -19,13 +18,13 @@ type Writer struct {
 	LogChannel chan string
 }
 
-func NewWriter(logChannel chan string, config *configPkg.Config) Writer {
+func NewWriter(logChannel chan string, debugFilePath string) Writer {
 	writer := Writer{
 		LogChannel: logChannel,
 	}
 
-	if config.DebugFile != "" {
-		debugFile, err := os.OpenFile(config.DebugFile, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0755)
+	if debugFilePath != "" {
+		debugFile, err := os.OpenFile(debugFilePath, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0755)
 		if err != nil {
 			panic(err)
 		}
@@ -48,14 +47,14 @@ func (w Writer) Write(msg []byte) (int, error) {
 	return len(msg), nil
 }
 
-func GetLogger(logChannel chan string, config *configPkg.Config) *zerolog.Logger {
+func GetLogger(logChannel chan string, debugFilePath string, verbose bool) *zerolog.Logger {
 	writer := zerolog.ConsoleWriter{
-		Out:     NewWriter(logChannel, config),
+		Out:     NewWriter(logChannel, debugFilePath),
 		NoColor: true,
 	}
 	log := zerolog.New(writer).With().Timestamp().Logger()
 
-	if config.Verbose {
+	if verbose {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	} else {
 		zerolog.SetGlobalLevel(zerolog.WarnLevel)
@@ -0,0 +1,103 @@
+package recorder
+
+import (
+	"main/pkg/types"
+	"sync"
+	"time"
+)
+
+var PlayerSpeeds = []float64{0.25, 0.5, 1, 2, 4, 8, 16, 32}
+
+const DefaultPlayerSpeedIndex = 2
+
+// Player replays the records with the same time intervals they were recorded with,
+// adjusted by the playback speed.
+type Player struct {
+	Records    []Record
+	Position   int
+	Clock      time.Time
+	SpeedIndex int
+
+	mutex sync.Mutex
+}
+
+func NewPlayer(records []Record) *Player {
+	return &Player{
+		Records:    records,
+		Position:   0,
+		Clock:      records[0].Time,
+		SpeedIndex: DefaultPlayerSpeedIndex,
+	}
+}
+
+// Advance moves the playback clock by the time passed, multiplied by speed,
+// and returns the records that should be applied.
+func (p *Player) Advance(elapsed time.Duration) []Record {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.seekTo(p.Clock.Add(time.Duration(float64(elapsed) * PlayerSpeeds[p.SpeedIndex])))
+}
+
+// Seek moves the playback clock by the offset. When seeking backwards, the playback
+// is restarted, so the second return value is true and all records till the new position
+// are returned, to rebuild the state from scratch.
+func (p *Player) Seek(offset time.Duration) ([]Record, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	clock := p.Clock.Add(offset)
+	if offset >= 0 {
+		return p.seekTo(clock), false
+	}
+
+	p.Position = 0
+	p.Clock = p.Records[0].Time
+
+	return p.seekTo(clock), true
+}
+
+func (p *Player) seekTo(clock time.Time) []Record {
+	lastRecordTime := p.Records[len(p.Records)-1].Time
+	if clock.After(lastRecordTime) {
+		clock = lastRecordTime
+	}
+
+	if clock.After(p.Clock) {
+		p.Clock = clock
+	}
+
+	start := p.Position
+	for p.Position < len(p.Records) && !p.Records[p.Position].Time.After(p.Clock) {
+		p.Position++
+	}
+
+	return p.Records[start:p.Position]
+}
+
+func (p *Player) ChangeSpeed(increase bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if increase && p.SpeedIndex+1 < len(PlayerSpeeds) {
+		p.SpeedIndex++
+	} else if !increase && p.SpeedIndex > 0 {
+		p.SpeedIndex--
+	}
+}
+
+func (p *Player) GetStatus(isPaused bool) *types.ReplayStatus {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	start := p.Records[0].Time
+
+	return &types.ReplayStatus{
+		Time:       p.Clock,
+		Elapsed:    p.Clock.Sub(start),
+		Duration:   p.Records[len(p.Records)-1].Time.Sub(start),
+		Speed:      PlayerSpeeds[p.SpeedIndex],
+		IsPaused:   isPaused,
+		IsFinished: p.Position >= len(p.Records),
+	}
+}
@@ -0,0 +1,158 @@
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// MaxRecordSize is the max size of a single record, consensus state on chains
+// with a lot of validators can be quite big.
+const MaxRecordSize = 64 * 1024 * 1024
+
+type RecordType string
+
+const (
+	RecordTypeConsensusState  RecordType = "consensus_state"
+	RecordTypeValidators      RecordType = "validators"
+	RecordTypeStatus          RecordType = "status"
+	RecordTypeSyncInfo        RecordType = "sync_info"
+	RecordTypeNetInfo         RecordType = "net_info"
+	RecordTypeMempool         RecordType = "mempool"
+	RecordTypeChainValidators RecordType = "chain_validators"
+	RecordTypeEvent           RecordType = "event"
+
+	RecordTypeDumpConsensusState RecordType = "dump_consensus_state"
+	RecordTypeBlocksHistory      RecordType = "blocks_history"
+
+	// RecordTypeChainSwitch is written when the displayed chain is switched, the replay
+	// starts over with an empty state on it.
+	RecordTypeChainSwitch RecordType = "chain_switch"
+)
+
+type Record struct {
+	Time time.Time       `json:"time"`
+	Type RecordType      `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Recorder appends every response it gets to a file, one JSON record per line.
+// Responses identical to the previous one of the same type are skipped, as most
+// of the polled data doesn't change between the requests.
+type Recorder struct {
+	Logger zerolog.Logger
+
+	file        *os.File
+	encoder     *json.Encoder
+	lastRecords map[RecordType][]byte
+	mutex       sync.Mutex
+}
+
+func NewRecorder(path string, logger zerolog.Logger) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644) //nolint:gosec // path is set by user
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		Logger:      logger.With().Str("component", "recorder").Logger(),
+		file:        file,
+		encoder:     json.NewEncoder(file),
+		lastRecords: make(map[RecordType][]byte),
+	}, nil
+}
+
+func (r *Recorder) Record(recordType RecordType, data interface{}) {
+	value, err := json.Marshal(data)
+	if err != nil {
+		r.Logger.Error().Err(err).Str("type", string(recordType)).Msg("Could not serialize record")
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Refresh goroutines might still be running after the recorder is closed on exit.
+	if r.file == nil {
+		return
+	}
+
+	if bytes.Equal(r.lastRecords[recordType], value) {
+		return
+	}
+
+	record := Record{Time: time.Now(), Type: recordType, Data: value}
+	if err := r.encoder.Encode(record); err != nil {
+		r.Logger.Error().Err(err).Str("type", string(recordType)).Msg("Could not write record")
+		return
+	}
+
+	// The new chain's data should be recorded even if it's the same as the previous chain's.
+	if recordType == RecordTypeChainSwitch {
+		r.lastRecords = make(map[RecordType][]byte)
+	}
+
+	r.lastRecords[recordType] = value
+}
+
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+
+	return err
+}
+
+// LoadRecords reads all records from the file. The last record might be incomplete
+// if the app was killed while writing it, so it's skipped.
+func LoadRecords(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), MaxRecordSize)
+
+	records := make([]Record, 0)
+	var lastErr error
+
+	for line := 1; scanner.Scan(); line++ {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			lastErr = fmt.Errorf("malformed record at line %d: %w", line, err)
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("no records found")
+	}
+
+	return records, nil
+}
@@ -0,0 +1,159 @@
+package recorder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestRecorderSkipsUnchangedRecords(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+
+	recorder, err := NewRecorder(path, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("could not create recorder: %s", err)
+	}
+
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "1"})
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "1"})
+	recorder.Record(RecordTypeNetInfo, map[string]string{"total": "1"})
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "2"})
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "1"})
+
+	// After switching chains, the new chain's data is recorded even if it's the same.
+	recorder.Record(RecordTypeChainSwitch, map[string]string{"name": "second"})
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "1"})
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder: %s", err)
+	}
+
+	// Records after closing are dropped instead of failing.
+	recorder.Record(RecordTypeMempool, map[string]string{"total": "3"})
+
+	records, err := LoadRecords(path)
+	if err != nil {
+		t.Fatalf("could not load records: %s", err)
+	}
+
+	expected := []struct {
+		recordType RecordType
+		data       string
+	}{
+		{RecordTypeMempool, `{"total":"1"}`},
+		{RecordTypeNetInfo, `{"total":"1"}`},
+		{RecordTypeMempool, `{"total":"2"}`},
+		{RecordTypeMempool, `{"total":"1"}`},
+		{RecordTypeChainSwitch, `{"name":"second"}`},
+		{RecordTypeMempool, `{"total":"1"}`},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+
+	for index, record := range records {
+		if record.Type != expected[index].recordType || string(record.Data) != expected[index].data {
+			t.Errorf(
+				"record #%d: expected %s %s, got %s %s",
+				index,
+				expected[index].recordType,
+				expected[index].data,
+				record.Type,
+				record.Data,
+			)
+		}
+	}
+}
+
+func TestLoadRecords(t *testing.T) {
+	t.Parallel()
+
+	record := `{"time":"2024-01-01T00:00:00Z","type":"mempool","data":{}}`
+
+	tests := []struct {
+		name     string
+		content  string
+		expected int
+		fails    bool
+	}{
+		{name: "complete", content: record + "\n" + record + "\n", expected: 2},
+		{name: "truncated last record", content: record + "\n" + `{"time":"2024-01-01T00:00:01Z","ty`, expected: 1},
+		{name: "malformed record in the middle", content: record + "\n{\n" + record + "\n", fails: true},
+		{name: "empty", content: "", fails: true},
+	}
+
+	for _, test := range tests {
+		path := filepath.Join(t.TempDir(), "session.jsonl")
+		if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+			t.Fatalf("%s: could not write records: %s", test.name, err)
+		}
+
+		records, err := LoadRecords(path)
+		if test.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %d records", test.name, len(records))
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+		} else if len(records) != test.expected {
+			t.Errorf("%s: expected %d records, got %d", test.name, test.expected, len(records))
+		}
+	}
+}
+
+func TestPlayer(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	records := make([]Record, 0)
+	for offset := range 5 {
+		records = append(records, Record{
+			Time: start.Add(time.Duration(offset) * 10 * time.Second),
+			Type: RecordTypeEvent,
+		})
+	}
+
+	player := NewPlayer(records)
+
+	// Records are played at 1x speed by default.
+	if applied := player.Advance(15 * time.Second); len(applied) != 2 {
+		t.Errorf("expected 2 records after 15s, got %d", len(applied))
+	}
+
+	player.ChangeSpeed(true)
+	if applied := player.Advance(5 * time.Second); len(applied) != 1 {
+		t.Errorf("expected 1 record after 5s at 2x, got %d", len(applied))
+	}
+
+	// Seeking backwards restarts the playback, returning all records till the new position.
+	applied, restarted := player.Seek(-10 * time.Second)
+	if !restarted || len(applied) != 2 {
+		t.Errorf("expected a restart with 2 records, got restarted=%t and %d records", restarted, len(applied))
+	}
+
+	applied, restarted = player.Seek(time.Hour)
+	if restarted || len(applied) != 3 {
+		t.Errorf("expected 3 records without a restart, got restarted=%t and %d records", restarted, len(applied))
+	}
+
+	status := player.GetStatus(false)
+	if !status.IsFinished || status.Elapsed != 40*time.Second || status.Duration != 40*time.Second {
+		t.Errorf(
+			"expected a finished playback at 40s/40s, got finished=%t at %s/%s",
+			status.IsFinished,
+			status.Elapsed,
+			status.Duration,
+		)
+	}
+}
@@ -0,0 +1,217 @@
+package pkg
+
+import (
+	"encoding/json"
+	"main/pkg/display"
+	"main/pkg/recorder"
+	"main/pkg/types"
+	"time"
+)
+
+const (
+	ReplayTickRate = 100 * time.Millisecond
+	ReplaySeekStep = 10 * time.Second
+)
+
+func (a *App) GoReplay(chain *Chain) {
+	defer a.HandlePanic()
+
+	ticker := time.NewTicker(ReplayTickRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-chain.Done:
+			return
+		case command := <-a.ReplayChannel:
+			chain = a.HandleReplayCommand(chain, command)
+		case <-ticker.C:
+			// Only redraw once after pausing, so the replay status shows it's paused.
+			if a.IsPaused {
+				chain.State.Lock()
+				redraw := chain.State.Replay != nil && !chain.State.Replay.IsPaused
+				if redraw {
+					chain.State.SetReplayStatus(a.Player.GetStatus(true))
+				}
+				chain.State.Unlock()
+
+				if redraw {
+					a.DisplayState(chain)
+				}
+
+				continue
+			}
+
+			chain = a.ApplyRecords(chain, a.Player.Advance(ReplayTickRate))
+			a.DisplayState(chain)
+		}
+	}
+}
+
+// HandleReplayCommand applies the replay command, returning the chain to continue replaying with,
+// as seeking backwards starts over with a new state.
+func (a *App) HandleReplayCommand(chain *Chain, command int) *Chain {
+	switch command {
+	case display.ReplayCommandSeekBackward, display.ReplayCommandSeekForward:
+		offset := ReplaySeekStep
+		if command == display.ReplayCommandSeekBackward {
+			offset = -ReplaySeekStep
+		}
+
+		records, restarted := a.Player.Seek(offset)
+
+		// Seeking backwards rebuilds the state from the start of the recording.
+		if restarted {
+			chain = a.ResetReplayState(chain)
+		}
+
+		chain = a.ApplyRecords(chain, records)
+	case display.ReplayCommandSpeedUp:
+		a.Player.ChangeSpeed(true)
+	case display.ReplayCommandSlowDown:
+		a.Player.ChangeSpeed(false)
+	}
+
+	chain.State.Lock()
+	chain.State.SetReplayStatus(a.Player.GetStatus(a.IsPaused))
+	chain.State.Unlock()
+
+	a.DisplayState(chain)
+
+	return chain
+}
+
+// ResetReplayState continues replaying with an empty state.
+func (a *App) ResetReplayState(chain *Chain) *Chain {
+	chain = chain.WithState(types.NewState(chain.Config.MyValidators))
+	a.Chain.Store(chain)
+	a.ReplayValidators = nil
+
+	return chain
+}
+
+// ApplyRecords applies the records to the chain's state, returning the chain to continue
+// replaying with, as a chain switch in the recording starts over with a new state.
+func (a *App) ApplyRecords(chain *Chain, records []recorder.Record) *Chain {
+	state := chain.State
+	state.Lock()
+
+	for _, record := range records {
+		// Data recorded after switching to another chain shouldn't be mixed with the previous chain's.
+		if record.Type == recorder.RecordTypeChainSwitch {
+			state.Unlock()
+			chain = a.ResetReplayState(chain)
+			state = chain.State
+			state.Lock()
+			continue
+		}
+
+		// Timings calculated while applying the record should be relative to the recording time.
+		status := a.Player.GetStatus(a.IsPaused)
+		status.Time = record.Time
+		state.SetReplayStatus(status)
+
+		if err := a.ApplyRecord(state, record); err != nil {
+			a.Logger.Error().
+				Err(err).
+				Str("type", string(record.Type)).
+				Time("time", record.Time).
+				Msg("Error applying record")
+		}
+	}
+
+	state.SetReplayStatus(a.Player.GetStatus(a.IsPaused))
+	state.Unlock()
+
+	return chain
+}
+
+// ApplyRecord applies a recorded response to the state, the caller should hold the state's lock.
+func (a *App) ApplyRecord(state *types.State, record recorder.Record) error {
+	switch record.Type {
+	case recorder.RecordTypeValidators:
+		var validators []types.TendermintValidator
+		if err := json.Unmarshal(record.Data, &validators); err != nil {
+			return err
+		}
+
+		a.ReplayValidators = validators
+	case recorder.RecordTypeConsensusState:
+		var consensus types.ConsensusStateResponse
+		if err := json.Unmarshal(record.Data, &consensus); err != nil {
+			return err
+		}
+
+		err := state.SetTendermintResponse(&consensus, a.ReplayValidators)
+		state.SetConsensusStateError(err)
+		return err
+	case recorder.RecordTypeStatus:
+		var status types.TendermintStatusResponse
+		if err := json.Unmarshal(record.Data, &status); err != nil {
+			return err
+		}
+
+		state.SetNodeStatus(&status.Result)
+	case recorder.RecordTypeSyncInfo:
+		var syncInfo types.TendermintSyncInfo
+		if err := json.Unmarshal(record.Data, &syncInfo); err != nil {
+			return err
+		}
+
+		state.SetNodeSyncInfo(&syncInfo)
+	case recorder.RecordTypeNetInfo:
+		var netInfo types.TendermintNetInfoResult
+		if err := json.Unmarshal(record.Data, &netInfo); err != nil {
+			return err
+		}
+
+		state.SetNetInfo(&netInfo)
+	case recorder.RecordTypeMempool:
+		var mempool types.TendermintUnconfirmedTxsResult
+		if err := json.Unmarshal(record.Data, &mempool); err != nil {
+			return err
+		}
+
+		state.SetMempool(&mempool)
+	case recorder.RecordTypeChainValidators:
+		var chainValidators types.ChainValidators
+		if err := json.Unmarshal(record.Data, &chainValidators); err != nil {
+			return err
+		}
+
+		state.SetChainValidators(&chainValidators)
+	case recorder.RecordTypeDumpConsensusState:
+		var dumpConsensusState types.DumpConsensusStateResult
+		if err := json.Unmarshal(record.Data, &dumpConsensusState); err != nil {
+			return err
+		}
+
+		state.SetDumpConsensusStateError(nil)
+		state.SetDumpConsensusState(dumpConsensusState.RoundState)
+		state.SetPeers(dumpConsensusState.Peers)
+	case recorder.RecordTypeBlocksHistory:
+		var blocks types.BlocksHistory
+		if err := json.Unmarshal(record.Data, &blocks); err != nil {
+			return err
+		}
+
+		history := state.BlocksHistory
+		if history == nil {
+			history = types.NewBlocksHistory(blocks.Size)
+		}
+
+		state.SetBlocksHistory(history.WithBlocks(blocks.Blocks))
+	case recorder.RecordTypeEvent:
+		var event types.TendermintEventData
+		if err := json.Unmarshal(record.Data, &event); err != nil {
+			return err
+		}
+
+		// If the state is outdated, the next recorded consensus state would fix it.
+		a.ApplyEvent(state, event)
+	default:
+		a.Logger.Debug().Str("type", string(record.Type)).Msg("Got unsupported record, skipping")
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"main/pkg/aggregator"
+	configPkg "main/pkg/config"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/types"
+	"os"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	SnapshotFormatJSON = "json"
+	SnapshotFormatText = "text"
+)
+
+// Snapshot fetches the consensus state, validators, chain info and upgrade once
+// and writes the merged state to the writer in the given format.
+func Snapshot(config *configPkg.Config, format string, writer io.Writer) error {
+	if format != SnapshotFormatJSON && format != SnapshotFormatText {
+		return fmt.Errorf(
+			"unsupported snapshot format: %s, expected '%s' or '%s'",
+			format,
+			SnapshotFormatJSON,
+			SnapshotFormatText,
+		)
+	}
+
+	// Logs go to stderr, so they won't break the output if it's piped somewhere.
+	logChannel := make(chan string)
+	go func() {
+		for logString := range logChannel {
+			_, _ = fmt.Fprint(os.Stderr, logString)
+		}
+	}()
+
+	logger := loggerPkg.GetLogger(logChannel, config.DebugFile, config.Verbose).
+		With().
+		Str("component", "snapshot").
+		Logger()
+
+	state, err := TakeSnapshot(config, logger)
+	if err != nil {
+		return err
+	}
+
+	snapshot := state.GetSnapshot()
+
+	if format == SnapshotFormatText {
+		_, err = fmt.Fprint(writer, snapshot.SerializeText(config.Timezone))
+		return err
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snapshot)
+}
+
+// TakeSnapshot runs a single refresh cycle and returns the resulting state. Only the consensus
+// state is required, if anything else fails, the error is stored in the state.
+func TakeSnapshot(config *configPkg.Config, logger zerolog.Logger) (*types.State, error) {
+	state := types.NewState(config.MyValidators)
+	aggregator := aggregator.NewAggregator(config, logger)
+	defer func() {
+		if err := aggregator.Close(); err != nil {
+			logger.Warn().Err(err).Msg("Could not close connections")
+		}
+	}()
+
+	var wg sync.WaitGroup
+
+	var consensusError error
+
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+
+		consensus, validators, err := aggregator.GetData()
+
+		state.Lock()
+		defer state.Unlock()
+
+		if err == nil {
+			err = state.SetTendermintResponse(consensus, validators)
+		}
+
+		consensusError = err
+		state.SetConsensusStateError(err)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		chainValidators, err := aggregator.GetChainValidators()
+		if err != nil {
+			logger.Error().Err(err).Msg("Error getting chain validators")
+		}
+
+		state.Lock()
+		defer state.Unlock()
+
+		state.SetChainValidatorsError(err)
+		if err == nil {
+			state.SetChainValidators(chainValidators)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		chainInfo, err := aggregator.GetChainInfo()
+		if err != nil {
+			logger.Error().Err(err).Msg("Error getting chain info")
+		}
+
+		state.Lock()
+		defer state.Unlock()
+
+		state.SetStatusError(err)
+		if err == nil {
+			state.SetNodeStatus(&chainInfo.Result)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		if config.HaltHeight > 0 {
+			state.Lock()
+			defer state.Unlock()
+
+			state.SetUpgrade(&types.Upgrade{
+				Name:   "halt-height upgrade",
+				Height: config.HaltHeight,
+			})
+			return
+		}
+
+		upgrade, err := aggregator.GetUpgrade()
+		if err != nil {
+			logger.Error().Err(err).Msg("Error getting upgrade")
+		}
+
+		state.Lock()
+		defer state.Unlock()
+
+		state.SetUpgradePlanError(err)
+		state.SetUpgrade(upgrade)
+	}()
+
+	wg.Wait()
+
+	if consensusError != nil {
+		return nil, fmt.Errorf("could not get consensus state: %w", consensusError)
+	}
+
+	// Block time is only needed to estimate the upgrade time, and it's quite expensive to fetch.
+	if state.Upgrade != nil {
+		blockTime, err := aggregator.GetBlockTime()
+		if err != nil {
+			logger.Error().Err(err).Msg("Error getting block time")
+		} else {
+			state.SetBlockTime(blockTime)
+		}
+	}
+
+	return state, nil
+}
@@ -7,6 +7,7 @@ import (
 	"main/pkg/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"main/pkg/types"
@@ -19,16 +20,27 @@ type RPC struct {
 	Logger     zerolog.Logger
 	Client     *http.Client
 	LogChannel chan string
+
+	// validatorsAddresses caches the validators sets by height, needed to know
+	// who the absent signatures in commits belong to.
+	validatorsAddresses      map[int64][]string
+	validatorsAddressesMutex sync.Mutex
 }
 
 func NewRPC(config *configPkg.Config, logger zerolog.Logger) *RPC {
 	return &RPC{
 		Config: config,
 		Logger: logger.With().Str("component", "tendermint_rpc").Logger(),
-		Client: http.NewClient(logger, "tendermint_rpc", config.RPCHost),
+		Client: http.NewClient(logger, "tendermint_rpc", config.FailoverTimeout, config.RPCHosts...),
+
+		validatorsAddresses: make(map[int64][]string),
 	}
 }
 
+func (rpc *RPC) CheckHealth() {
+	rpc.Client.CheckHealth("/health")
+}
+
 func (rpc *RPC) GetConsensusState() (*types.ConsensusStateResponse, error) {
 	var response types.ConsensusStateResponse
 	if err := rpc.Client.Get("/consensus_state", &response); err != nil {
@@ -39,12 +51,17 @@ func (rpc *RPC) GetConsensusState() (*types.ConsensusStateResponse, error) {
 }
 
 func (rpc *RPC) GetValidators() ([]types.TendermintValidator, error) {
+	return rpc.GetValidatorsAtHeight(0)
+}
+
+// GetValidatorsAtHeight returns the validators set at the given height, or the latest one if it's 0.
+func (rpc *RPC) GetValidatorsAtHeight(height int64) ([]types.TendermintValidator, error) {
 	page := 1
 
 	validators := make([]types.TendermintValidator, 0)
 
 	for {
-		response, err := rpc.GetValidatorsAtPage(page)
+		response, err := rpc.GetValidatorsAtPage(page, height)
 		if err != nil {
 			return nil, err
 		}
@@ -83,18 +100,29 @@ func (rpc *RPC) GetValidators() ([]types.TendermintValidator, error) {
 }
 
 func (rpc *RPC) GetValidatorsViaDumpConsensusState() ([]types.TendermintValidator, error) {
+	dumpConsensusState, err := rpc.GetDumpConsensusState()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dumpConsensusState.RoundState.Validators.Validators) == 0 {
+		return nil, fmt.Errorf("malformed response from /dump_consensus_state")
+	}
+
+	return dumpConsensusState.RoundState.Validators.Validators, nil
+}
+
+func (rpc *RPC) GetDumpConsensusState() (*types.DumpConsensusStateResult, error) {
 	var response types.DumpConsensusStateResponse
 	if err := rpc.Client.Get("/dump_consensus_state", &response); err != nil {
 		return nil, err
 	}
 
-	if response.Result == nil ||
-		response.Result.RoundState == nil ||
-		len(response.Result.RoundState.Validators.Validators) == 0 {
+	if response.Result == nil || response.Result.RoundState == nil {
 		return nil, fmt.Errorf("malformed response from /dump_consensus_state")
 	}
 
-	return response.Result.RoundState.Validators.Validators, nil
+	return response.Result, nil
 }
 
 func (rpc *RPC) GetStatus() (*types.TendermintStatusResponse, error) {
@@ -106,9 +134,40 @@ func (rpc *RPC) GetStatus() (*types.TendermintStatusResponse, error) {
 	return &response, nil
 }
 
-func (rpc *RPC) GetValidatorsAtPage(page int) (*types.ValidatorsResponse, error) {
+func (rpc *RPC) GetNetInfo() (*types.TendermintNetInfoResult, error) {
+	var response types.TendermintNetInfoResponse
+	if err := rpc.Client.Get("/net_info", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Result == nil {
+		return nil, errors.New("malformed response from /net_info")
+	}
+
+	return response.Result, nil
+}
+
+func (rpc *RPC) GetUnconfirmedTxs() (*types.TendermintUnconfirmedTxsResult, error) {
+	var response types.TendermintUnconfirmedTxsResponse
+	if err := rpc.Client.Get("/num_unconfirmed_txs", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Result == nil {
+		return nil, errors.New("malformed response from /num_unconfirmed_txs")
+	}
+
+	return response.Result, nil
+}
+
+func (rpc *RPC) GetValidatorsAtPage(page int, height int64) (*types.ValidatorsResponse, error) {
+	url := fmt.Sprintf("/validators?page=%d&per_page=100", page)
+	if height > 0 {
+		url += fmt.Sprintf("&height=%d", height)
+	}
+
 	var response types.ValidatorsResponse
-	if err := rpc.Client.Get(fmt.Sprintf("/validators?page=%d&per_page=100", page), &response); err != nil {
+	if err := rpc.Client.Get(url, &response); err != nil {
 		return nil, err
 	}
 
@@ -126,6 +185,116 @@ func (rpc *RPC) Block(height int64) (types.TendermintBlockResponse, error) {
 	return res, err
 }
 
+func (rpc *RPC) GetCommit(height int64) (*types.TendermintCommitResult, error) {
+	commitURL := "/commit"
+	if height != 0 {
+		commitURL = fmt.Sprintf("/commit?height=%d", height)
+	}
+
+	var response types.TendermintCommitResponse
+	if err := rpc.Client.Get(commitURL, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil {
+		return nil, fmt.Errorf("malformed response from node: %s: %s", response.Error.Message, response.Error.Data)
+	}
+
+	if response.Result == nil {
+		return nil, errors.New("malformed response from node: no result")
+	}
+
+	return response.Result, nil
+}
+
+// GetValidatorsAddressesAtHeight returns the addresses of the validators set at the given height,
+// in the same order as the signatures in this height's commit. The result is cached by height.
+func (rpc *RPC) GetValidatorsAddressesAtHeight(height int64) ([]string, error) {
+	rpc.validatorsAddressesMutex.Lock()
+	addresses, ok := rpc.validatorsAddresses[height]
+	rpc.validatorsAddressesMutex.Unlock()
+
+	if ok {
+		return addresses, nil
+	}
+
+	validators, err := rpc.GetValidatorsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses = make([]string, len(validators))
+	for index, validator := range validators {
+		addresses[index] = validator.Address
+	}
+
+	rpc.validatorsAddressesMutex.Lock()
+	rpc.validatorsAddresses[height] = addresses
+	rpc.validatorsAddressesMutex.Unlock()
+
+	return addresses, nil
+}
+
+func (rpc *RPC) GetBlockSignatures(commit *types.TendermintCommitResult) (types.BlockSignatures, error) {
+	if !commit.HasAbsentSignatures() {
+		return commit.ToBlockSignatures([]string{})
+	}
+
+	height, err := strconv.ParseInt(commit.SignedHeader.Commit.Height, 10, 64)
+	if err != nil {
+		return types.BlockSignatures{}, err
+	}
+
+	addresses, err := rpc.GetValidatorsAddressesAtHeight(height)
+	if err != nil {
+		return types.BlockSignatures{}, err
+	}
+
+	return commit.ToBlockSignatures(addresses)
+}
+
+// GetBlocksSignatures returns the signatures for the latest blocks after knownHeight,
+// but no more than limit blocks.
+func (rpc *RPC) GetBlocksSignatures(knownHeight int64, limit int64) ([]types.BlockSignatures, error) {
+	latestCommit, err := rpc.GetCommit(0)
+	if err != nil {
+		return nil, err
+	}
+
+	latestBlock, err := rpc.GetBlockSignatures(latestCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	fromHeight := max(knownHeight+1, latestBlock.Height-limit+1, 1)
+	blocks := []types.BlockSignatures{latestBlock}
+
+	for height := fromHeight; height < latestBlock.Height; height++ {
+		commit, err := rpc.GetCommit(height)
+		if err != nil {
+			return nil, err
+		}
+
+		block, err := rpc.GetBlockSignatures(commit)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	// Older heights won't be fetched again, so there's no need to keep their validators sets.
+	rpc.validatorsAddressesMutex.Lock()
+	for height := range rpc.validatorsAddresses {
+		if height < fromHeight {
+			delete(rpc.validatorsAddresses, height)
+		}
+	}
+	rpc.validatorsAddressesMutex.Unlock()
+
+	return blocks, nil
+}
+
 func (rpc *RPC) GetBlockTime() (time.Duration, error) {
 	latestBlock, err := rpc.Block(0)
 	if err != nil {
@@ -0,0 +1,167 @@
+package tendermint
+
+import (
+	"fmt"
+	tmhttp "main/pkg/http"
+	"main/pkg/types"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+const (
+	WebsocketReconnectInterval = 5 * time.Second
+	WebsocketReadTimeout       = time.Minute
+)
+
+var WebsocketQueries = []string{
+	"tm.event='Vote'",
+	"tm.event='NewRoundStep'",
+	"tm.event='CompleteProposal'",
+	"tm.event='NewBlock'",
+}
+
+type WebsocketClient struct {
+	Logger zerolog.Logger
+	// Client is used to get the RPC host that currently works.
+	Client        *tmhttp.Client
+	EventsChannel chan types.TendermintEventData
+
+	connected atomic.Bool
+}
+
+func NewWebsocketClient(client *tmhttp.Client, logger zerolog.Logger) *WebsocketClient {
+	return &WebsocketClient{
+		Logger:        logger.With().Str("component", "tendermint_websocket").Logger(),
+		Client:        client,
+		EventsChannel: make(chan types.TendermintEventData),
+	}
+}
+
+func (c *WebsocketClient) IsConnected() bool {
+	return c.connected.Load()
+}
+
+func (c *WebsocketClient) Listen(done chan bool) {
+	for {
+		if err := c.ListenOnce(done); err != nil {
+			c.Logger.Warn().Err(err).Msg("Websocket connection failed, falling back to polling")
+		}
+
+		c.connected.Store(false)
+
+		select {
+		case <-done:
+			return
+		case <-time.After(WebsocketReconnectInterval):
+		}
+	}
+}
+
+func (c *WebsocketClient) ListenOnce(done chan bool) error {
+	websocketURL, err := GetWebsocketURL(c.Client.GetActiveHost())
+	if err != nil {
+		return err
+	}
+
+	c.Logger.Debug().Str("url", websocketURL).Msg("Connecting to websocket...")
+
+	header := http.Header{}
+	header.Set("User-Agent", "tmtop")
+
+	conn, _, err := websocket.DefaultDialer.Dial(websocketURL, header)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	// Closing the connection on stop, so the blocking read below would return.
+	finished := make(chan bool)
+	defer close(finished)
+
+	go func() {
+		select {
+		case <-done:
+			_ = conn.Close()
+		case <-finished:
+		}
+	}()
+
+	conn.SetPingHandler(func(data string) error {
+		_ = conn.SetReadDeadline(time.Now().Add(WebsocketReadTimeout))
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+
+	for index, query := range WebsocketQueries {
+		if err := conn.WriteJSON(types.TendermintSubscribeRequest{
+			JSONRPC: "2.0",
+			Method:  "subscribe",
+			ID:      index,
+			Params:  types.TendermintSubscribeParams{Query: query},
+		}); err != nil {
+			return err
+		}
+	}
+
+	c.connected.Store(true)
+	c.Logger.Debug().Str("url", websocketURL).Msg("Subscribed to websocket events")
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(WebsocketReadTimeout)); err != nil {
+			return ErrorUnlessStopped(done, err)
+		}
+
+		var response types.TendermintEventResponse
+		if err := conn.ReadJSON(&response); err != nil {
+			return ErrorUnlessStopped(done, err)
+		}
+
+		if response.Error != nil {
+			return fmt.Errorf("error from websocket: %s: %s", response.Error.Message, response.Error.Data)
+		}
+
+		// Subscription confirmations come with an empty result.
+		if response.Result == nil || response.Result.Data == nil {
+			continue
+		}
+
+		select {
+		case <-done:
+			return nil
+		case c.EventsChannel <- *response.Result.Data:
+		}
+	}
+}
+
+// ErrorUnlessStopped returns the error, unless it's caused by closing the connection on stop.
+func ErrorUnlessStopped(done chan bool, err error) error {
+	select {
+	case <-done:
+		return nil
+	default:
+		return err
+	}
+}
+
+func GetWebsocketURL(host string) (string, error) {
+	parsed, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	switch parsed.Scheme {
+	case "https", "wss":
+		parsed.Scheme = "wss"
+	default:
+		parsed.Scheme = "ws"
+	}
+
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/") + "/websocket"
+	return parsed.String(), nil
+}
@@ -0,0 +1,161 @@
+package tendermint
+
+import (
+	tmhttp "main/pkg/http"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+const (
+	testVoteEvent  = `{"jsonrpc":"2.0","id":0,"result":{"query":"tm.event='Vote'","data":{"type":"tendermint/event/Vote","value":{"Vote":{"type":1,"height":"100","round":0,"block_id":{"hash":"ABCDEF1234567890"},"timestamp":"2024-01-01T00:00:02Z","validator_address":"A1B2C3D4E5F60718293A4B5C6D7E8F9012345678","validator_index":0,"signature":"AAAA"}}}}}`
+	testRoundEvent = `{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewRoundStep'","data":{"type":"tendermint/event/RoundState","value":{"height":"100","round":1,"step":"RoundStepNewRound"}}}}`
+)
+
+// newTestWebsocketServer starts a fake RPC node, which sends the queries it was subscribed to
+// to the channel and then sends the messages to the client.
+func newTestWebsocketServer(t *testing.T, queries chan<- string, messages ...string) *httptest.Server {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/websocket" {
+			http.NotFound(w, r)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("could not upgrade connection: %s", err)
+			return
+		}
+
+		defer conn.Close()
+
+		for range WebsocketQueries {
+			var request types.TendermintSubscribeRequest
+			if err := conn.ReadJSON(&request); err != nil {
+				t.Errorf("could not read subscribe request: %s", err)
+				return
+			}
+
+			queries <- request.Params.Query
+
+			// Subscription confirmation.
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":0,"result":{}}`)); err != nil {
+				return
+			}
+		}
+
+		for _, message := range messages {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+
+		// Waiting for the client to disconnect.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestWebsocketClientListenOnce(t *testing.T) {
+	t.Parallel()
+
+	queries := make(chan string, len(WebsocketQueries))
+	server := newTestWebsocketServer(t, queries, testVoteEvent, testRoundEvent)
+
+	client := NewWebsocketClient(tmhttp.NewClient(zerolog.Nop(), "test", time.Second, server.URL), zerolog.Nop())
+	done := make(chan bool)
+	result := make(chan error, 1)
+
+	go func() {
+		result <- client.ListenOnce(done)
+	}()
+
+	for _, expected := range []string{types.EventTypeVote, types.EventTypeRoundState} {
+		select {
+		case event := <-client.EventsChannel:
+			if event.Type != expected {
+				t.Errorf("expected %s event, got %s", expected, event.Type)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s event", expected)
+		}
+	}
+
+	if !client.IsConnected() {
+		t.Error("expected client to be connected")
+	}
+
+	for _, expected := range WebsocketQueries {
+		if query := <-queries; query != expected {
+			t.Errorf("expected subscription to %s, got %s", expected, query)
+		}
+	}
+
+	close(done)
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("expected no error after stopping, got %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the client to stop")
+	}
+}
+
+func TestWebsocketClientListenOnceError(t *testing.T) {
+	t.Parallel()
+
+	queries := make(chan string, len(WebsocketQueries))
+	server := newTestWebsocketServer(
+		t,
+		queries,
+		`{"jsonrpc":"2.0","id":0,"error":{"code":-32603,"message":"Internal error","data":"max_subscriptions_per_client reached"}}`,
+	)
+
+	client := NewWebsocketClient(tmhttp.NewClient(zerolog.Nop(), "test", time.Second, server.URL), zerolog.Nop())
+
+	if err := client.ListenOnce(make(chan bool)); err == nil {
+		t.Error("expected an error from the websocket")
+	}
+}
+
+func TestGetWebsocketURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{host: "http://localhost:26657", expected: "ws://localhost:26657/websocket"},
+		{host: "https://rpc.example.com", expected: "wss://rpc.example.com/websocket"},
+		{host: "http://localhost:26657/", expected: "ws://localhost:26657/websocket"},
+		{host: "https://example.com/rpc", expected: "wss://example.com/rpc/websocket"},
+		{host: "https://example.com/rpc/", expected: "wss://example.com/rpc/websocket"},
+	}
+
+	for _, test := range tests {
+		websocketURL, err := GetWebsocketURL(test.host)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.host, err)
+		} else if websocketURL != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.host, test.expected, websocketURL)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+type AlertType string
+
+const (
+	AlertTypeMissedPrevote   AlertType = "missed_prevote"
+	AlertTypeMissedPrecommit AlertType = "missed_precommit"
+	AlertTypeStuckHeight     AlertType = "stuck_height"
+	AlertTypeHighRound       AlertType = "high_round"
+)
+
+type Alert struct {
+	Type      AlertType `json:"type"`
+	Chain     string    `json:"chain"`
+	Message   string    `json:"message"`
+	Height    int64     `json:"height"`
+	Round     int64     `json:"round"`
+	Validator string    `json:"validator,omitempty"`
+	Time      time.Time `json:"time"`
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// BlockHashColors are the colors validators voting for different blocks are displayed with,
+// from the block with the most voting power to the one with the least.
+var BlockHashColors = []string{"yellow", "fuchsia", "orange", "red", "blue", "purple"}
+
+type BlockHashVotes struct {
+	Hash               string
+	VotingPower        *big.Int
+	VotingPowerPercent *big.Float
+	ValidatorsCount    int
+	Color              string
+}
+
+func (v BlockHashVotes) IsNil() bool {
+	return v.Hash == ""
+}
+
+func (v BlockHashVotes) Serialize() string {
+	if v.IsNil() {
+		return fmt.Sprintf(" prevotes for nil: %.2f%%", v.VotingPowerPercent)
+	}
+
+	if v.Color == "" {
+		return fmt.Sprintf(" prevotes for %s: %.2f%%", v.Hash, v.VotingPowerPercent)
+	}
+
+	return fmt.Sprintf(" prevotes for [%s]%s[-]: %.2f%%", v.Color, v.Hash, v.VotingPowerPercent)
+}
+
+// BlockHashesVotes is the voting power that prevoted for each block hash (or for nil),
+// sorted by voting power descending.
+type BlockHashesVotes []BlockHashVotes
+
+func (v ValidatorsWithRoundVote) GetPrevotesByBlockHash() BlockHashesVotes {
+	totalVP := v.GetTotalVotingPower()
+	votesByHash := make(map[string]*BlockHashVotes)
+
+	for _, validator := range v {
+		if validator.RoundVote.Prevote == VotedNil {
+			continue
+		}
+
+		hash := validator.RoundVote.PrevoteHash
+		if _, ok := votesByHash[hash]; !ok {
+			votesByHash[hash] = &BlockHashVotes{Hash: hash, VotingPower: big.NewInt(0)}
+		}
+
+		votesByHash[hash].VotingPower.Add(votesByHash[hash].VotingPower, validator.Validator.VotingPower)
+		votesByHash[hash].ValidatorsCount++
+	}
+
+	hashesVotes := make(BlockHashesVotes, 0, len(votesByHash))
+	for _, hashVotes := range votesByHash {
+		hashVotes.VotingPowerPercent = big.NewFloat(0)
+		if totalVP.Sign() > 0 {
+			hashVotes.VotingPowerPercent.SetInt(hashVotes.VotingPower)
+			hashVotes.VotingPowerPercent.Quo(hashVotes.VotingPowerPercent, big.NewFloat(0).SetInt(totalVP))
+			hashVotes.VotingPowerPercent.Mul(hashVotes.VotingPowerPercent, big.NewFloat(100))
+		}
+
+		hashesVotes = append(hashesVotes, *hashVotes)
+	}
+
+	sort.Slice(hashesVotes, func(i, j int) bool {
+		if cmp := hashesVotes[i].VotingPower.Cmp(hashesVotes[j].VotingPower); cmp != 0 {
+			return cmp > 0
+		}
+
+		return hashesVotes[i].Hash < hashesVotes[j].Hash
+	})
+
+	// Colors are only needed to tell blocks apart, so they are only assigned if validators
+	// voted for more than one block.
+	if hashesVotes.GetBlocksCount() > 1 {
+		colorIndex := 0
+
+		for index := range hashesVotes {
+			if hashesVotes[index].IsNil() {
+				continue
+			}
+
+			hashesVotes[index].Color = BlockHashColors[colorIndex%len(BlockHashColors)]
+			colorIndex++
+		}
+	}
+
+	return hashesVotes
+}
+
+// GetBlocksCount returns the amount of distinct blocks validators voted for, excluding nil.
+func (v BlockHashesVotes) GetBlocksCount() int {
+	count := 0
+
+	for _, hashVotes := range v {
+		if !hashVotes.IsNil() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// GetColor returns the color for the block hash, or an empty string if it has none.
+func (v BlockHashesVotes) GetColor(hash string) string {
+	for _, hashVotes := range v {
+		if hashVotes.Hash == hash {
+			return hashVotes.Color
+		}
+	}
+
+	return ""
+}
+
+func (v BlockHashesVotes) Serialize() string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	serialized := make([]string, len(v))
+	for index, hashVotes := range v {
+		serialized[index] = hashVotes.Serialize()
+	}
+
+	return strings.Join(serialized, "\n")
+}
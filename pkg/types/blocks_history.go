@@ -0,0 +1,94 @@
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// BlockSignatures is a record of which validators have signed a committed block.
+type BlockSignatures struct {
+	Height     int64
+	Time       time.Time
+	Signatures map[string]Vote
+	Absent     map[string]bool
+}
+
+// GetVote returns the validator's vote for this block, or false if the validator
+// was not in the validator set at this height.
+func (b BlockSignatures) GetVote(address string) (Vote, bool) {
+	if vote, ok := b.Signatures[address]; ok {
+		return vote, true
+	}
+
+	if b.Absent[address] {
+		return VotedNil, true
+	}
+
+	return VotedNil, false
+}
+
+// BlocksHistory is a ring buffer keeping the signatures for the last Size blocks,
+// sorted by height ascending. It's never modified in place, WithBlocks returns a new one,
+// so it can be safely read while newer blocks are being fetched.
+type BlocksHistory struct {
+	Size   int
+	Blocks []BlockSignatures
+}
+
+func NewBlocksHistory(size int) *BlocksHistory {
+	return &BlocksHistory{
+		Size:   size,
+		Blocks: []BlockSignatures{},
+	}
+}
+
+func (h *BlocksHistory) GetLatestHeight() int64 {
+	if len(h.Blocks) == 0 {
+		return 0
+	}
+
+	return h.Blocks[len(h.Blocks)-1].Height
+}
+
+func (h *BlocksHistory) WithBlocks(blocks []BlockSignatures) *BlocksHistory {
+	blocksByHeight := make(map[int64]BlockSignatures, len(h.Blocks)+len(blocks))
+	for _, block := range h.Blocks {
+		blocksByHeight[block.Height] = block
+	}
+
+	for _, block := range blocks {
+		blocksByHeight[block.Height] = block
+	}
+
+	newBlocks := make([]BlockSignatures, 0, len(blocksByHeight))
+	for _, block := range blocksByHeight {
+		newBlocks = append(newBlocks, block)
+	}
+
+	sort.Slice(newBlocks, func(i, j int) bool {
+		return newBlocks[i].Height < newBlocks[j].Height
+	})
+
+	if len(newBlocks) > h.Size {
+		newBlocks = newBlocks[len(newBlocks)-h.Size:]
+	}
+
+	return &BlocksHistory{
+		Size:   h.Size,
+		Blocks: newBlocks,
+	}
+}
+
+// GetMissedCount returns the amount of blocks the validator did not sign,
+// counting only the blocks where the validator was in the active set.
+func (h *BlocksHistory) GetMissedCount(address string) int {
+	missed := 0
+
+	for _, block := range h.Blocks {
+		if vote, ok := block.GetVote(address); ok && vote == VotedNil {
+			missed++
+		}
+	}
+
+	return missed
+}
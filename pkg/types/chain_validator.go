@@ -1,11 +1,51 @@
 package types
 
+import (
+	"math/big"
+	"strings"
+)
+
+type ValidatorStatus string
+
+const (
+	ValidatorStatusBonded    ValidatorStatus = "bonded"
+	ValidatorStatusUnbonding ValidatorStatus = "unbonding"
+	ValidatorStatusUnbonded  ValidatorStatus = "unbonded"
+	ValidatorStatusUnknown   ValidatorStatus = "unknown"
+)
+
 type ChainValidator struct {
 	Moniker            string
 	Address            string
 	RawAddress         string
 	AssignedAddress    string
 	RawAssignedAddress string
+	OperatorAddress    string
+	SigningInfo        *SigningInfo
+	Status             ValidatorStatus
+	Tokens             *big.Int
+	DelegatorShares    float64
+	Commission         float64
+	Jailed             bool
+}
+
+func (c ChainValidator) Matches(query string) bool {
+	query = strings.ToLower(query)
+
+	for _, value := range []string{
+		c.Moniker,
+		c.Address,
+		c.RawAddress,
+		c.AssignedAddress,
+		c.RawAssignedAddress,
+		c.OperatorAddress,
+	} {
+		if strings.Contains(strings.ToLower(value), query) {
+			return true
+		}
+	}
+
+	return false
 }
 
 type ChainValidators []ChainValidator
@@ -22,3 +62,17 @@ func (c ChainValidators) ToMap() map[string]ChainValidator {
 
 	return valsMap
 }
+
+// GetConsensusAddress returns the validator's hex consensus address on this chain, which is
+// its assigned key address on consumer chains if it has one.
+func (c ChainValidator) GetConsensusAddress() string {
+	if c.RawAssignedAddress != "" {
+		return c.RawAssignedAddress
+	}
+
+	return c.Address
+}
+
+func (c ChainValidator) IsMine(myValidators MyValidators) bool {
+	return myValidators.Matches(c.GetConsensusAddress(), c.Address, c.OperatorAddress)
+}
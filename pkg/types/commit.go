@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	BlockIDFlagAbsent = 1
+	BlockIDFlagCommit = 2
+	BlockIDFlagNil    = 3
+)
+
+type TendermintCommitResponse struct {
+	Result *TendermintCommitResult `json:"result"`
+	Error  *ValidatorsError        `json:"error"`
+}
+
+type TendermintCommitResult struct {
+	SignedHeader TendermintSignedHeader `json:"signed_header"`
+}
+
+type TendermintSignedHeader struct {
+	Header TendermintBlockHeader `json:"header"`
+	Commit TendermintCommit      `json:"commit"`
+}
+
+type TendermintCommit struct {
+	Height     string                      `json:"height"`
+	Round      int64                       `json:"round"`
+	Signatures []TendermintCommitSignature `json:"signatures"`
+}
+
+type TendermintCommitSignature struct {
+	BlockIDFlag      int       `json:"block_id_flag"`
+	ValidatorAddress string    `json:"validator_address"`
+	Timestamp        time.Time `json:"timestamp"`
+	Signature        string    `json:"signature"`
+}
+
+func (s TendermintCommitSignature) ToVote() Vote {
+	switch s.BlockIDFlag {
+	case BlockIDFlagCommit:
+		return Voted
+	case BlockIDFlagNil:
+		return VotedZero
+	default:
+		return VotedNil
+	}
+}
+
+// HasAbsentSignatures returns whether some validators did not sign this commit,
+// so the validators set at this height is needed to know who they are.
+func (c TendermintCommitResult) HasAbsentSignatures() bool {
+	for _, signature := range c.SignedHeader.Commit.Signatures {
+		if signature.BlockIDFlag == BlockIDFlagAbsent {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToBlockSignatures converts the commit to the block signatures. Absent signatures have
+// no validator address, so they are resolved by their index in validatorsAddresses,
+// which is the validators set at the commit's height, in the same order as in the commit.
+func (c TendermintCommitResult) ToBlockSignatures(validatorsAddresses []string) (BlockSignatures, error) {
+	height, err := strconv.ParseInt(c.SignedHeader.Commit.Height, 10, 64)
+	if err != nil {
+		return BlockSignatures{}, err
+	}
+
+	block := BlockSignatures{
+		Height:     height,
+		Time:       c.SignedHeader.Header.Time,
+		Signatures: make(map[string]Vote, len(c.SignedHeader.Commit.Signatures)),
+		Absent:     make(map[string]bool),
+	}
+
+	for index, signature := range c.SignedHeader.Commit.Signatures {
+		if signature.BlockIDFlag == BlockIDFlagAbsent {
+			if index >= len(validatorsAddresses) {
+				return BlockSignatures{}, fmt.Errorf(
+					"could not find validator #%d in validators set at height %d",
+					index,
+					height,
+				)
+			}
+
+			block.Absent[validatorsAddresses[index]] = true
+			continue
+		}
+
+		block.Signatures[signature.ValidatorAddress] = signature.ToVote()
+	}
+
+	return block, nil
+}
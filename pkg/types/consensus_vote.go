@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ConsensusVoteNil = "nil-Vote"
+	EmptyBlockHash   = "000000000000"
+)
+
+// ParsedVote is a vote parsed from its string representation returned by /consensus_state,
+// which looks like this (CometBFT v0.38 also adds the vote extension fingerprint after the signature):
+// Vote{0:A1B2C3D4E5F6 100/00/SIGNED_MSG_TYPE_PREVOTE(Prevote) ABCDEF123456 8E2B2C35C8C9 @ 2024-01-01T00:00:00.123Z}.
+// Addresses, hashes and signatures are only the first 6 bytes of the actual values.
+type ParsedVote struct {
+	ValidatorIndex   int
+	ValidatorAddress string
+	Height           int64
+	Round            int64
+	Type             int
+	BlockHash        string
+	Signature        string
+	Timestamp        time.Time
+}
+
+func (v ParsedVote) IsForBlock() bool {
+	return v.BlockHash != EmptyBlockHash
+}
+
+// Parse returns the parsed vote, or nil if the validator has not voted.
+func (v ConsensusVote) Parse() (*ParsedVote, error) {
+	source := string(v)
+	if source == ConsensusVoteNil || source == "" {
+		return nil, nil //nolint:nilnil // not voted is not an error
+	}
+
+	if !strings.HasPrefix(source, "Vote{") || !strings.HasSuffix(source, "}") {
+		return nil, fmt.Errorf("malformed vote: %s", source)
+	}
+
+	source = strings.TrimSuffix(strings.TrimPrefix(source, "Vote{"), "}")
+
+	voteAndTimestamp := strings.Split(source, " @ ")
+	if len(voteAndTimestamp) != 2 {
+		return nil, fmt.Errorf("malformed vote, expected timestamp: %s", v)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, voteAndTimestamp[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed vote timestamp: %w", err)
+	}
+
+	fields := strings.Fields(voteAndTimestamp[0])
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("malformed vote, expected at least 4 fields: %s", v)
+	}
+
+	indexAndAddress := strings.Split(fields[0], ":")
+	if len(indexAndAddress) != 2 {
+		return nil, fmt.Errorf("malformed vote validator: %s", fields[0])
+	}
+
+	index, err := strconv.Atoi(indexAndAddress[0])
+	if err != nil {
+		return nil, fmt.Errorf("malformed vote validator index: %w", err)
+	}
+
+	heightRoundType := strings.Split(fields[1], "/")
+	if len(heightRoundType) != 3 {
+		return nil, fmt.Errorf("malformed vote height/round/type: %s", fields[1])
+	}
+
+	height, err := strconv.ParseInt(heightRoundType[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed vote height: %w", err)
+	}
+
+	round, err := strconv.ParseInt(heightRoundType[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed vote round: %w", err)
+	}
+
+	var voteType int
+
+	switch {
+	case strings.HasPrefix(heightRoundType[2], "SIGNED_MSG_TYPE_PREVOTE"):
+		voteType = VoteTypePrevote
+	case strings.HasPrefix(heightRoundType[2], "SIGNED_MSG_TYPE_PRECOMMIT"):
+		voteType = VoteTypePrecommit
+	default:
+		return nil, fmt.Errorf("malformed vote type: %s", heightRoundType[2])
+	}
+
+	return &ParsedVote{
+		ValidatorIndex:   index,
+		ValidatorAddress: indexAndAddress[1],
+		Height:           height,
+		Round:            round,
+		Type:             voteType,
+		BlockHash:        fields[2],
+		Signature:        fields[3],
+		Timestamp:        timestamp,
+	}, nil
+}
@@ -29,12 +29,12 @@ func ValidatorsWithLatestRoundFromTendermintResponse(
 				Address:     validator.Address,
 				VotingPower: vp,
 			},
-			RoundVote: RoundVote{
-				Address:    validator.Address,
-				Precommit:  VoteFromString(precommit),
-				Prevote:    VoteFromString(prevote),
-				IsProposer: validator.Address == consensus.Result.RoundState.Proposer.Address,
-			},
+			RoundVote: NewRoundVote(
+				validator.Address,
+				prevote,
+				precommit,
+				validator.Address == consensus.Result.RoundState.Proposer.Address,
+			),
 		}
 	}
 
@@ -91,12 +91,12 @@ func ValidatorsWithAllRoundsFromTendermintResponse(
 		for index, prevote := range roundHeightVoteSet.Prevotes {
 			precommit := roundHeightVoteSet.Precommits[index]
 			validator := tendermintValidators[index]
-			currentRoundVotes[index] = RoundVote{
-				Address:    validator.Address,
-				Precommit:  VoteFromString(precommit),
-				Prevote:    VoteFromString(prevote),
-				IsProposer: validator.Address == consensus.Result.RoundState.Proposer.Address,
-			}
+			currentRoundVotes[index] = NewRoundVote(
+				validator.Address,
+				prevote,
+				precommit,
+				validator.Address == consensus.Result.RoundState.Proposer.Address,
+			)
 		}
 
 		roundsVotes[round] = currentRoundVotes
@@ -108,11 +108,49 @@ func ValidatorsWithAllRoundsFromTendermintResponse(
 	}, nil
 }
 
+func NewRoundVote(address string, prevote, precommit ConsensusVote, isProposer bool) RoundVote {
+	roundVote := RoundVote{
+		Address:      address,
+		Precommit:    VoteFromString(precommit),
+		Prevote:      VoteFromString(prevote),
+		IsProposer:   isProposer,
+		RawPrevote:   prevote,
+		RawPrecommit: precommit,
+	}
+
+	// Timestamps are only used for displaying vote timings, so votes that cannot be parsed
+	// are still displayed, just without timings.
+	if parsed, err := prevote.Parse(); err == nil && parsed != nil {
+		roundVote.PrevoteTime = parsed.Timestamp
+		if parsed.IsForBlock() {
+			roundVote.PrevoteHash = parsed.BlockHash
+		}
+	}
+
+	if parsed, err := precommit.Parse(); err == nil && parsed != nil {
+		roundVote.PrecommitTime = parsed.Timestamp
+		if parsed.IsForBlock() {
+			roundVote.PrecommitHash = parsed.BlockHash
+		}
+	}
+
+	return roundVote
+}
+
 func VoteFromString(source ConsensusVote) Vote {
-	if source == "nil-Vote" {
+	if source == ConsensusVoteNil {
 		return VotedNil
 	}
 
+	if parsed, err := source.Parse(); err == nil && parsed != nil {
+		if !parsed.IsForBlock() {
+			return VotedZero
+		}
+
+		return Voted
+	}
+
+	// Falling back to the substring check if the vote format is not the one we expect.
 	if strings.Contains(string(source), "SIGNED_MSG_TYPE_PREVOTE(Prevote) 000000000000") {
 		return VotedZero
 	}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// MyValidator is a validator the user runs, identified either by its consensus address
+// or by its operator address.
+type MyValidator struct {
+	Address         string
+	OperatorAddress string
+}
+
+func (m MyValidator) GetName() string {
+	if m.Address != "" {
+		return m.Address
+	}
+
+	return m.OperatorAddress
+}
+
+// Matches returns true if either the validator's address on this chain, its address
+// on the provider chain (for consumer chains) or its operator address belongs to this validator.
+func (m MyValidator) Matches(address, providerAddress, operatorAddress string) bool {
+	if m.Address != "" && (address == m.Address || providerAddress == m.Address) {
+		return true
+	}
+
+	if m.OperatorAddress == "" || operatorAddress == "" {
+		return false
+	}
+
+	// Comparing the decoded bytes, so the prefix doesn't matter.
+	_, myBytes, err := bech32.Decode(m.OperatorAddress)
+	if err != nil {
+		return false
+	}
+
+	_, operatorBytes, err := bech32.Decode(operatorAddress)
+	return err == nil && bytes.Equal(myBytes, operatorBytes)
+}
+
+type MyValidators []MyValidator
+
+func (m MyValidators) Matches(address, providerAddress, operatorAddress string) bool {
+	for _, myValidator := range m {
+		if myValidator.Matches(address, providerAddress, operatorAddress) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+package types
+
+type TendermintNetInfoResponse struct {
+	Result *TendermintNetInfoResult `json:"result"`
+}
+
+type TendermintNetInfoResult struct {
+	Listening bool             `json:"listening"`
+	NPeers    string           `json:"n_peers"`
+	Peers     []TendermintPeer `json:"peers"`
+}
+
+type TendermintPeer struct {
+	NodeInfo   TendermintNodeInfo `json:"node_info"`
+	IsOutbound bool               `json:"is_outbound"`
+	RemoteIP   string             `json:"remote_ip"`
+}
+
+// GetPeersCount returns the amount of inbound and outbound peers.
+func (r TendermintNetInfoResult) GetPeersCount() (int, int) {
+	inbound, outbound := 0, 0
+
+	for _, peer := range r.Peers {
+		if peer.IsOutbound {
+			outbound++
+		} else {
+			inbound++
+		}
+	}
+
+	return inbound, outbound
+}
+
+type TendermintUnconfirmedTxsResponse struct {
+	Result *TendermintUnconfirmedTxsResult `json:"result"`
+}
+
+type TendermintUnconfirmedTxsResult struct {
+	NTxs       string `json:"n_txs"`
+	Total      string `json:"total"`
+	TotalBytes string `json:"total_bytes"`
+}
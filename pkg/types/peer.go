@@ -0,0 +1,176 @@
+package types
+
+import (
+	"net"
+	"strings"
+)
+
+type DumpConsensusStatePeer struct {
+	NodeAddress string                      `json:"node_address"`
+	PeerState   DumpConsensusStatePeerState `json:"peer_state"`
+}
+
+type DumpConsensusStatePeerState struct {
+	RoundState PeerRoundState `json:"round_state"`
+}
+
+// PeerRoundState is the consensus state of a peer as our node knows it.
+type PeerRoundState struct {
+	Height     string         `json:"height"`
+	Round      int64          `json:"round"`
+	Step       int64          `json:"step"`
+	Prevotes   PeerVotesArray `json:"prevotes"`
+	Precommits PeerVotesArray `json:"precommits"`
+}
+
+// PeerVotesArray is a bit array of the votes a peer has for its height and round,
+// serialized as "xx_x", where "x" means the peer has the vote of the validator with this index.
+type PeerVotesArray string
+
+// Has returns whether the peer has the vote of the validator with the given index,
+// and whether it's known at all.
+func (a PeerVotesArray) Has(index int) (bool, bool) {
+	if index < 0 || index >= len(a) {
+		return false, false
+	}
+
+	return a[index] == 'x', true
+}
+
+// GetID returns the peer's node ID from its address, which looks like id@ip:port.
+func (p DumpConsensusStatePeer) GetID() string {
+	id, _, _ := strings.Cut(p.NodeAddress, "@")
+	return id
+}
+
+func (p DumpConsensusStatePeer) GetIP() string {
+	_, address, found := strings.Cut(p.NodeAddress, "@")
+	if !found {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	return host
+}
+
+type PeerVotesStatus int
+
+const (
+	PeerVotesUnknown PeerVotesStatus = iota
+	PeerVotesReceived
+	PeerVotesMissing
+)
+
+func (s PeerVotesStatus) Serialize() string {
+	switch s {
+	case PeerVotesReceived:
+		return "[green]yes[-]"
+	case PeerVotesMissing:
+		return "[red]no[-]"
+	default:
+		return "-"
+	}
+}
+
+type PeerWithInfo struct {
+	ID         string
+	RemoteIP   string
+	Moniker    string
+	IsOutbound *bool
+	RoundState PeerRoundState
+	// OurPrevotes and OurPrecommits are whether the peer has the votes of our validators,
+	// only known if it's at the same height and round as our node.
+	OurPrevotes   PeerVotesStatus
+	OurPrecommits PeerVotesStatus
+}
+
+func (p PeerWithInfo) Matches(query string) bool {
+	query = strings.ToLower(query)
+
+	for _, value := range []string{p.ID, p.RemoteIP, p.Moniker} {
+		if strings.Contains(strings.ToLower(value), query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type PeersWithInfo []PeerWithInfo
+
+// GetPeersWithInfo returns the peers from the consensus state dump, along with their monikers
+// from the node's net info and whether they have our validators' votes.
+func (s *State) GetPeersWithInfo() PeersWithInfo {
+	peers := make(PeersWithInfo, len(s.Peers))
+
+	netInfoPeers := make(map[string]TendermintPeer)
+	if s.NetInfo != nil {
+		for _, peer := range s.NetInfo.Peers {
+			netInfoPeers[peer.NodeInfo.ID] = peer
+		}
+	}
+
+	myValidatorsIndexes := make([]int, 0)
+	myValidators := s.GetMyValidators()
+
+	for _, validator := range s.GetValidatorsWithInfo() {
+		if validator.IsMine(myValidators) {
+			myValidatorsIndexes = append(myValidatorsIndexes, validator.Validator.Index)
+		}
+	}
+
+	for index, peer := range s.Peers {
+		peerWithInfo := PeerWithInfo{
+			ID:         peer.GetID(),
+			RemoteIP:   peer.GetIP(),
+			RoundState: peer.PeerState.RoundState,
+		}
+
+		if netInfoPeer, ok := netInfoPeers[peerWithInfo.ID]; ok {
+			isOutbound := netInfoPeer.IsOutbound
+			peerWithInfo.Moniker = netInfoPeer.NodeInfo.Moniker
+			peerWithInfo.IsOutbound = &isOutbound
+
+			if peerWithInfo.RemoteIP == "" {
+				peerWithInfo.RemoteIP = netInfoPeer.RemoteIP
+			}
+		}
+
+		// Peer's votes are for its own height and round, so they can only be compared to ours
+		// if it's at the same height and round as our node.
+		roundState := peer.PeerState.RoundState
+		if s.DumpConsensusState != nil &&
+			roundState.Height == s.DumpConsensusState.Height &&
+			roundState.Round == s.DumpConsensusState.Round {
+			peerWithInfo.OurPrevotes = getPeerVotesStatus(roundState.Prevotes, myValidatorsIndexes)
+			peerWithInfo.OurPrecommits = getPeerVotesStatus(roundState.Precommits, myValidatorsIndexes)
+		}
+
+		peers[index] = peerWithInfo
+	}
+
+	return peers
+}
+
+func getPeerVotesStatus(votes PeerVotesArray, indexes []int) PeerVotesStatus {
+	if len(indexes) == 0 {
+		return PeerVotesUnknown
+	}
+
+	for _, index := range indexes {
+		has, known := votes.Has(index)
+		if !known {
+			return PeerVotesUnknown
+		}
+
+		if !has {
+			return PeerVotesMissing
+		}
+	}
+
+	return PeerVotesReceived
+}
@@ -0,0 +1,224 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// PriorityWindowSizeFactor is the same as in CometBFT: priorities are rescaled so that
+// the difference between the max and min priorities is at most this times the total voting power.
+const PriorityWindowSizeFactor = 2
+
+type ScheduledProposer struct {
+	Height  int64
+	Round   int64
+	Address string
+}
+
+type ProposersSchedule []ScheduledProposer
+
+// GetNextProposal returns the first scheduled proposal of a validator matching the predicate.
+func (s ProposersSchedule) GetNextProposal(predicate func(address string) bool) (ScheduledProposer, bool) {
+	for _, proposer := range s {
+		if predicate(proposer.Address) {
+			return proposer, true
+		}
+	}
+
+	return ScheduledProposer{}, false
+}
+
+type proposerPriorityValidator struct {
+	Address          string
+	VotingPower      int64
+	ProposerPriority int64
+}
+
+// proposerPrioritySet replicates CometBFT's weighted round-robin proposer selection
+// (ValidatorSet.IncrementProposerPriority), assuming the validator set doesn't change.
+type proposerPrioritySet struct {
+	Validators       []*proposerPriorityValidator
+	TotalVotingPower int64
+}
+
+func newProposerPrioritySet(validators []TendermintValidator) (*proposerPrioritySet, error) {
+	set := &proposerPrioritySet{
+		Validators: make([]*proposerPriorityValidator, len(validators)),
+	}
+
+	for index, validator := range validators {
+		votingPower, err := strconv.ParseInt(validator.VotingPower, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		priority, err := strconv.ParseInt(validator.ProposerPriority, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		set.Validators[index] = &proposerPriorityValidator{
+			Address:          strings.ToUpper(validator.Address),
+			VotingPower:      votingPower,
+			ProposerPriority: priority,
+		}
+		set.TotalVotingPower = safeAddClip(set.TotalVotingPower, votingPower)
+	}
+
+	if set.TotalVotingPower <= 0 {
+		return nil, errors.New("total voting power is zero")
+	}
+
+	return set, nil
+}
+
+func (s *proposerPrioritySet) copy() *proposerPrioritySet {
+	validators := make([]*proposerPriorityValidator, len(s.Validators))
+	for index, validator := range s.Validators {
+		validatorCopy := *validator
+		validators[index] = &validatorCopy
+	}
+
+	return &proposerPrioritySet{
+		Validators:       validators,
+		TotalVotingPower: s.TotalVotingPower,
+	}
+}
+
+func (s *proposerPrioritySet) incrementProposerPriority(times int64) *proposerPriorityValidator {
+	s.rescalePriorities(PriorityWindowSizeFactor * s.TotalVotingPower)
+	s.shiftByAvgProposerPriority()
+
+	var proposer *proposerPriorityValidator
+	for i := int64(0); i < times; i++ {
+		for _, validator := range s.Validators {
+			validator.ProposerPriority = safeAddClip(validator.ProposerPriority, validator.VotingPower)
+		}
+
+		proposer = s.getValidatorWithMostPriority()
+		proposer.ProposerPriority = safeSubClip(proposer.ProposerPriority, s.TotalVotingPower)
+	}
+
+	return proposer
+}
+
+func (s *proposerPrioritySet) rescalePriorities(diffMax int64) {
+	if len(s.Validators) == 0 || diffMax <= 0 {
+		return
+	}
+
+	maxPriority, minPriority := int64(math.MinInt64), int64(math.MaxInt64)
+	for _, validator := range s.Validators {
+		maxPriority = max(maxPriority, validator.ProposerPriority)
+		minPriority = min(minPriority, validator.ProposerPriority)
+	}
+
+	diff := maxPriority - minPriority
+	if diff < 0 {
+		diff = -diff
+	}
+
+	if diff <= diffMax {
+		return
+	}
+
+	ratio := (diff + diffMax - 1) / diffMax
+	for _, validator := range s.Validators {
+		validator.ProposerPriority /= ratio
+	}
+}
+
+func (s *proposerPrioritySet) shiftByAvgProposerPriority() {
+	if len(s.Validators) == 0 {
+		return
+	}
+
+	sum := big.NewInt(0)
+	for _, validator := range s.Validators {
+		sum.Add(sum, big.NewInt(validator.ProposerPriority))
+	}
+
+	// big.Int's Div is the Euclidean division, same as CometBFT uses.
+	average := sum.Div(sum, big.NewInt(int64(len(s.Validators)))).Int64()
+
+	for _, validator := range s.Validators {
+		validator.ProposerPriority = safeSubClip(validator.ProposerPriority, average)
+	}
+}
+
+func (s *proposerPrioritySet) getValidatorWithMostPriority() *proposerPriorityValidator {
+	var result *proposerPriorityValidator
+
+	for _, validator := range s.Validators {
+		if result == nil ||
+			validator.ProposerPriority > result.ProposerPriority ||
+			(validator.ProposerPriority == result.ProposerPriority && validator.Address < result.Address) {
+			result = validator
+		}
+	}
+
+	return result
+}
+
+// GetProposersSchedule predicts the proposers for the next rounds of the current height
+// and for the first round of the next heights, based on the validators' proposer priorities
+// at the current height. The prediction is only correct if the validator set doesn't change.
+func GetProposersSchedule(
+	validators []TendermintValidator,
+	height int64,
+	round int64,
+	roundsCount int,
+	heightsCount int,
+) (ProposersSchedule, error) {
+	set, err := newProposerPrioritySet(validators)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make(ProposersSchedule, 0, roundsCount+heightsCount)
+
+	// Each new round of the current height increments the priorities of the validator set
+	// at this height once, same as CometBFT does when entering a new round.
+	roundsSet := set.copy()
+	for nextRound := int64(1); nextRound <= round+int64(roundsCount); nextRound++ {
+		proposer := roundsSet.incrementProposerPriority(1)
+		if nextRound > round {
+			schedule = append(schedule, ScheduledProposer{Height: height, Round: nextRound, Address: proposer.Address})
+		}
+	}
+
+	// Validator set for each next height is the previous one with the priorities incremented once.
+	for nextHeight := height + 1; nextHeight <= height+int64(heightsCount); nextHeight++ {
+		proposer := set.incrementProposerPriority(1)
+		schedule = append(schedule, ScheduledProposer{Height: nextHeight, Round: 0, Address: proposer.Address})
+	}
+
+	return schedule, nil
+}
+
+func safeAddClip(a, b int64) int64 {
+	if b > 0 && a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+
+	if b < 0 && a < math.MinInt64-b {
+		return math.MinInt64
+	}
+
+	return a + b
+}
+
+func safeSubClip(a, b int64) int64 {
+	if b > 0 && a < math.MinInt64+b {
+		return math.MinInt64
+	}
+
+	if b < 0 && a > math.MaxInt64+b {
+		return math.MaxInt64
+	}
+
+	return a - b
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"strconv"
+	"time"
+)
+
+type ReplayStatus struct {
+	Time       time.Time
+	Elapsed    time.Duration
+	Duration   time.Duration
+	Speed      float64
+	IsPaused   bool
+	IsFinished bool
+}
+
+func (s ReplayStatus) Serialize(timezone *time.Location) string {
+	status := ""
+
+	switch {
+	case s.IsFinished:
+		status = ", finished"
+	case s.IsPaused:
+		status = ", paused"
+	}
+
+	return fmt.Sprintf(
+		" [yellow]replay: %s / %s at %sx%s (%s)[-]\n",
+		s.Elapsed.Round(time.Second),
+		s.Duration.Round(time.Second),
+		strconv.FormatFloat(s.Speed, 'f', -1, 64),
+		status,
+		utils.SerializeTime(s.Time.In(timezone)),
+	)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"strings"
+	"time"
+)
+
+type RPCEndpoint struct {
+	URL     string
+	Active  bool
+	Healthy bool
+	Latency time.Duration
+	Error   error
+}
+
+func (e RPCEndpoint) Serialize() string {
+	status := "up"
+	if !e.Healthy {
+		status = "down"
+	}
+
+	if e.Active {
+		status = "active, " + status
+	}
+
+	if e.Latency == 0 {
+		return fmt.Sprintf("%s (%s)", e.URL, status)
+	}
+
+	return fmt.Sprintf("%s (%s, %s)", e.URL, status, utils.SerializeDuration(e.Latency))
+}
+
+type RPCEndpoints []RPCEndpoint
+
+func (e RPCEndpoints) Serialize() string {
+	serialized := make([]string, len(e))
+	for index, endpoint := range e {
+		serialized[index] = endpoint.Serialize()
+	}
+
+	return strings.Join(serialized, ", ")
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"math"
+	"strings"
+	"time"
+)
+
+// SigningInfoWarningThreshold is the share of the blocks a validator can miss in the signing
+// window before getting jailed, after which it's considered to be approaching the threshold.
+const SigningInfoWarningThreshold = 0.5
+
+// SigningInfo is the validator's slashing module signing info along with the slashing params
+// needed to interpret it.
+type SigningInfo struct {
+	MissedBlocks       int64
+	SignedBlocksWindow int64
+	MinSignedPerWindow float64
+	JailedUntil        time.Time
+	Tombstoned         bool
+}
+
+// GetMaxMissedBlocks returns how many blocks can be missed in the signing window without
+// getting jailed, the same way the slashing module calculates it.
+func (i SigningInfo) GetMaxMissedBlocks() int64 {
+	minSignedBlocks := int64(math.Floor(float64(i.SignedBlocksWindow) * i.MinSignedPerWindow))
+	return i.SignedBlocksWindow - minSignedBlocks
+}
+
+func (i SigningInfo) GetUptime() float64 {
+	if i.SignedBlocksWindow <= 0 {
+		return 0
+	}
+
+	return float64(i.SignedBlocksWindow-i.MissedBlocks) / float64(i.SignedBlocksWindow) * 100
+}
+
+func (i SigningInfo) IsJailed(now time.Time) bool {
+	return i.JailedUntil.After(now)
+}
+
+func (i SigningInfo) IsApproachingThreshold() bool {
+	maxMissedBlocks := i.GetMaxMissedBlocks()
+	if maxMissedBlocks <= 0 {
+		return false
+	}
+
+	return float64(i.MissedBlocks) >= float64(maxMissedBlocks)*SigningInfoWarningThreshold
+}
+
+// GetColor returns the color to display the validator uptime with: red if it's jailed
+// or tombstoned, yellow if it's approaching the threshold, empty otherwise.
+func (i SigningInfo) GetColor(now time.Time) string {
+	if i.Tombstoned || i.IsJailed(now) {
+		return "red"
+	}
+
+	if i.IsApproachingThreshold() {
+		return "yellow"
+	}
+
+	return ""
+}
+
+// SerializeStatus returns a short red marker if the validator is tombstoned or jailed
+// at the time passed, empty otherwise.
+func (i SigningInfo) SerializeStatus(now time.Time) string {
+	if i.Tombstoned {
+		return "[red]tombstoned[-]"
+	}
+
+	if i.IsJailed(now) {
+		return "[red]jailed[-]"
+	}
+
+	return ""
+}
+
+// Serialize returns the uptime, colored if the validator is about to be jailed or is jailed at the time passed.
+func (i SigningInfo) Serialize(now time.Time) string {
+	text := fmt.Sprintf("%.2f%%", i.GetUptime())
+
+	if color := i.GetColor(now); color != "" {
+		return fmt.Sprintf("[%s]%s[-]", color, text)
+	}
+
+	return text
+}
+
+func (i SigningInfo) SerializeDetails(now time.Time) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(" uptime: %s\n", i.Serialize(now)))
+	sb.WriteString(fmt.Sprintf(
+		" missed blocks: %d / %d (jailed after missing %d)\n",
+		i.MissedBlocks,
+		i.SignedBlocksWindow,
+		i.GetMaxMissedBlocks()+1,
+	))
+
+	if i.IsJailed(now) {
+		sb.WriteString(fmt.Sprintf(" [red]jailed until: %s[-]\n", utils.SerializeTime(i.JailedUntil)))
+	}
+
+	if i.Tombstoned {
+		sb.WriteString(" [red]tombstoned[-]\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,271 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Snapshot is the state at a single point in time, in a format suitable for scripting.
+type Snapshot struct {
+	ChainID           string              `json:"chain_id,omitempty"`
+	TendermintVersion string              `json:"tendermint_version,omitempty"`
+	Time              time.Time           `json:"time"`
+	Height            int64               `json:"height"`
+	Round             int64               `json:"round"`
+	Step              string              `json:"step"`
+	StartTime         time.Time           `json:"start_time"`
+	BlockTime         float64             `json:"block_time_seconds,omitempty"`
+	Prevotes          SnapshotVotesTotal  `json:"prevotes"`
+	Precommits        SnapshotVotesTotal  `json:"precommits"`
+	Upgrade           *SnapshotUpgrade    `json:"upgrade,omitempty"`
+	Validators        []SnapshotValidator `json:"validators"`
+	Errors            []string            `json:"errors,omitempty"`
+}
+
+type SnapshotVotesTotal struct {
+	TotalPercent    float64 `json:"total_percent"`
+	AgreeingPercent float64 `json:"agreeing_percent"`
+}
+
+type SnapshotUpgrade struct {
+	Name          string     `json:"name"`
+	Height        int64      `json:"height"`
+	BlocksLeft    int64      `json:"blocks_left"`
+	EstimatedTime *time.Time `json:"estimated_time,omitempty"`
+}
+
+type SnapshotValidator struct {
+	Index              int                  `json:"index"`
+	Address            string               `json:"address"`
+	Moniker            string               `json:"moniker,omitempty"`
+	OperatorAddress    string               `json:"operator_address,omitempty"`
+	VotingPower        string               `json:"voting_power"`
+	VotingPowerPercent float64              `json:"voting_power_percent"`
+	IsProposer         bool                 `json:"is_proposer"`
+	IsMine             bool                 `json:"is_mine"`
+	Prevote            string               `json:"prevote"`
+	Precommit          string               `json:"precommit"`
+	Rounds             []SnapshotRoundVote  `json:"rounds"`
+	SigningInfo        *SnapshotSigningInfo `json:"signing_info,omitempty"`
+}
+
+type SnapshotSigningInfo struct {
+	MissedBlocks       int64      `json:"missed_blocks"`
+	SignedBlocksWindow int64      `json:"signed_blocks_window"`
+	UptimePercent      float64    `json:"uptime_percent"`
+	JailedUntil        *time.Time `json:"jailed_until,omitempty"`
+	Tombstoned         bool       `json:"tombstoned"`
+}
+
+type SnapshotRoundVote struct {
+	Round     int    `json:"round"`
+	Prevote   string `json:"prevote"`
+	Precommit string `json:"precommit"`
+}
+
+func (s *State) GetSnapshot() Snapshot {
+	snapshot := Snapshot{
+		Time:       s.Now(),
+		Height:     s.Height,
+		Round:      s.Round,
+		Step:       RoundStepName(s.Step),
+		StartTime:  s.StartTime,
+		BlockTime:  s.BlockTime.Seconds(),
+		Validators: make([]SnapshotValidator, 0),
+		Errors:     make([]string, 0),
+	}
+
+	if s.NodeStatus != nil {
+		snapshot.ChainID = s.NodeStatus.NodeInfo.Network
+		snapshot.TendermintVersion = s.NodeStatus.NodeInfo.Version
+	}
+
+	for _, err := range []error{
+		s.ConsensusStateError,
+		s.ValidatorsError,
+		s.ChainValidatorsError,
+		s.UpgradePlanError,
+		s.StatusError,
+	} {
+		if err != nil {
+			snapshot.Errors = append(snapshot.Errors, err.Error())
+		}
+	}
+
+	if s.Upgrade != nil {
+		upgrade := &SnapshotUpgrade{
+			Name:       s.Upgrade.Name,
+			Height:     s.Upgrade.Height,
+			BlocksLeft: s.Upgrade.Height - s.Height,
+		}
+
+		if s.BlockTime != 0 && s.Height > 0 {
+			upgradeTime := utils.CalculateTimeTillBlock(s.Height, s.Upgrade.Height, s.BlockTime)
+			upgrade.EstimatedTime = &upgradeTime
+		}
+
+		snapshot.Upgrade = upgrade
+	}
+
+	if s.Validators == nil {
+		return snapshot
+	}
+
+	snapshot.Prevotes.TotalPercent, _ = s.Validators.GetTotalVotingPowerPrevotedPercent(true).Float64()
+	snapshot.Prevotes.AgreeingPercent, _ = s.Validators.GetTotalVotingPowerPrevotedPercent(false).Float64()
+	snapshot.Precommits.TotalPercent, _ = s.Validators.GetTotalVotingPowerPrecommittedPercent(true).Float64()
+	snapshot.Precommits.AgreeingPercent, _ = s.Validators.GetTotalVotingPowerPrecommittedPercent(false).Float64()
+
+	myValidators := s.GetMyValidators()
+	allRoundsVotes := s.GetValidatorsWithInfoAndAllRoundVotes()
+
+	for _, validator := range s.GetValidatorsWithInfo() {
+		votingPowerPercent, _ := validator.Validator.VotingPowerPercent.Float64()
+
+		snapshotValidator := SnapshotValidator{
+			Index:              validator.Validator.Index,
+			Address:            validator.Validator.Address,
+			VotingPower:        validator.Validator.VotingPower.String(),
+			VotingPowerPercent: votingPowerPercent,
+			IsProposer:         validator.RoundVote.IsProposer,
+			IsMine:             validator.IsMine(myValidators),
+			Prevote:            validator.RoundVote.Prevote.String(),
+			Precommit:          validator.RoundVote.Precommit.String(),
+			Rounds:             make([]SnapshotRoundVote, 0, len(allRoundsVotes.RoundsVotes)),
+		}
+
+		if validator.ChainValidator != nil {
+			snapshotValidator.Moniker = validator.ChainValidator.Moniker
+			snapshotValidator.OperatorAddress = validator.ChainValidator.OperatorAddress
+		}
+
+		if validator.ChainValidator != nil && validator.ChainValidator.SigningInfo != nil {
+			signingInfo := validator.ChainValidator.SigningInfo
+			snapshotValidator.SigningInfo = &SnapshotSigningInfo{
+				MissedBlocks:       signingInfo.MissedBlocks,
+				SignedBlocksWindow: signingInfo.SignedBlocksWindow,
+				UptimePercent:      signingInfo.GetUptime(),
+				Tombstoned:         signingInfo.Tombstoned,
+			}
+
+			if signingInfo.IsJailed(s.Now()) {
+				snapshotValidator.SigningInfo.JailedUntil = &signingInfo.JailedUntil
+			}
+		}
+
+		for round, roundVotes := range allRoundsVotes.RoundsVotes {
+			if validator.Validator.Index >= len(roundVotes) {
+				continue
+			}
+
+			roundVote := roundVotes[validator.Validator.Index]
+			snapshotValidator.Rounds = append(snapshotValidator.Rounds, SnapshotRoundVote{
+				Round:     round,
+				Prevote:   roundVote.Prevote.String(),
+				Precommit: roundVote.Precommit.String(),
+			})
+		}
+
+		snapshot.Validators = append(snapshot.Validators, snapshotValidator)
+	}
+
+	return snapshot
+}
+
+// SerializeText returns the snapshot as a plain-text summary and a table of validators,
+// without any colors or emojis, so it can be pasted anywhere.
+func (s Snapshot) SerializeText(timezone *time.Location) string {
+	var sb strings.Builder
+
+	if s.ChainID != "" {
+		sb.WriteString(fmt.Sprintf("chain: %s\n", s.ChainID))
+	}
+
+	sb.WriteString(fmt.Sprintf("time: %s\n", utils.SerializeTime(s.Time.In(timezone))))
+	sb.WriteString(fmt.Sprintf("height: %d, round: %d, step: %s\n", s.Height, s.Round, s.Step))
+
+	if !s.StartTime.IsZero() && s.Height > 0 {
+		sb.WriteString(fmt.Sprintf("height started: %s ago\n", utils.SerializeDuration(s.Time.Sub(s.StartTime))))
+	}
+
+	sb.WriteString(fmt.Sprintf(
+		"prevotes: %.2f%% total, %.2f%% agreeing\n",
+		s.Prevotes.TotalPercent,
+		s.Prevotes.AgreeingPercent,
+	))
+	sb.WriteString(fmt.Sprintf(
+		"precommits: %.2f%% total, %.2f%% agreeing\n",
+		s.Precommits.TotalPercent,
+		s.Precommits.AgreeingPercent,
+	))
+
+	if s.Upgrade != nil {
+		sb.WriteString(fmt.Sprintf(
+			"upgrade: %s at height %d, %d blocks left",
+			s.Upgrade.Name,
+			s.Upgrade.Height,
+			s.Upgrade.BlocksLeft,
+		))
+
+		if s.Upgrade.EstimatedTime != nil {
+			sb.WriteString(fmt.Sprintf(", estimated time: %s", utils.SerializeTime(s.Upgrade.EstimatedTime.In(timezone))))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	for _, err := range s.Errors {
+		sb.WriteString(fmt.Sprintf("error: %s\n", err))
+	}
+
+	if len(s.Validators) == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("\n")
+
+	writer := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "#\tVALIDATOR\tVOTING POWER\tPREVOTE\tPRECOMMIT\tUPTIME")
+
+	for _, validator := range s.Validators {
+		name := validator.Moniker
+		if name == "" {
+			name = validator.Address
+		}
+
+		var flags []string
+		if validator.IsProposer {
+			flags = append(flags, "proposer")
+		}
+		if validator.IsMine {
+			flags = append(flags, "mine")
+		}
+		if len(flags) > 0 {
+			name = fmt.Sprintf("%s (%s)", name, strings.Join(flags, ", "))
+		}
+
+		uptime := "-"
+		if validator.SigningInfo != nil {
+			uptime = fmt.Sprintf("%.2f%%", validator.SigningInfo.UptimePercent)
+		}
+
+		_, _ = fmt.Fprintf(
+			writer,
+			"%s\t%s\t%.2f%%\t%s\t%s\t%s\n",
+			strconv.Itoa(validator.Index+1),
+			name,
+			validator.VotingPowerPercent,
+			validator.Prevote,
+			validator.Precommit,
+			uptime,
+		)
+	}
+
+	_ = writer.Flush()
+
+	return sb.String()
+}
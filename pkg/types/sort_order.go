@@ -0,0 +1,113 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+type SortOrder int
+
+const (
+	SortByIndex SortOrder = iota
+	SortByVotingPower
+	SortByMoniker
+	SortByNotVotedFirst
+	SortByDisagreeingFirst
+	SortBySlowestFirst
+	SortOrdersCount
+)
+
+func (o SortOrder) Next() SortOrder {
+	return (o + 1) % SortOrdersCount
+}
+
+func (o SortOrder) String() string {
+	switch o {
+	case SortByVotingPower:
+		return "voting power"
+	case SortByMoniker:
+		return "moniker"
+	case SortByNotVotedFirst:
+		return "not voted first"
+	case SortByDisagreeingFirst:
+		return "disagreeing first"
+	case SortBySlowestFirst:
+		return "slowest first"
+	default:
+		return "index"
+	}
+}
+
+// Less reports whether the first validator should be displayed before the second one,
+// falling back to the validators' index if they are equal by the chosen criteria.
+func (o SortOrder) Less(first, second ValidatorWithInfo) bool {
+	switch o {
+	case SortByVotingPower:
+		if cmp := first.Validator.VotingPower.Cmp(second.Validator.VotingPower); cmp != 0 {
+			return cmp > 0
+		}
+	case SortByMoniker:
+		firstName, secondName := strings.ToLower(first.GetName()), strings.ToLower(second.GetName())
+		if firstName != secondName {
+			return firstName < secondName
+		}
+	case SortByNotVotedFirst:
+		if firstRank, secondRank := notVotedRank(first.RoundVote), notVotedRank(second.RoundVote); firstRank != secondRank {
+			return firstRank < secondRank
+		}
+	case SortByDisagreeingFirst:
+		if firstRank, secondRank := disagreeingRank(first.RoundVote), disagreeingRank(second.RoundVote); firstRank != secondRank {
+			return firstRank < secondRank
+		}
+	case SortBySlowestFirst:
+		if less, equal := slowerFirst(first.RoundVote, second.RoundVote); !equal {
+			return less
+		}
+	default:
+	}
+
+	return first.Validator.Index < second.Validator.Index
+}
+
+func notVotedRank(vote RoundVote) int {
+	if vote.Prevote == VotedNil {
+		return 0
+	}
+
+	if vote.Precommit == VotedNil {
+		return 1
+	}
+
+	return 2
+}
+
+func disagreeingRank(vote RoundVote) int {
+	if vote.Prevote == VotedZero || vote.Precommit == VotedZero {
+		return 0
+	}
+
+	return 1
+}
+
+// slowerFirst compares votes by prevote time and then by precommit time,
+// treating validators that have not voted as the slowest ones.
+func slowerFirst(first, second RoundVote) (bool, bool) {
+	for _, times := range [][2]time.Time{
+		{first.PrevoteTime, second.PrevoteTime},
+		{first.PrecommitTime, second.PrecommitTime},
+	} {
+		firstTime, secondTime := times[0], times[1]
+
+		if firstTime.Equal(secondTime) {
+			continue
+		}
+
+		if firstTime.IsZero() || secondTime.IsZero() {
+			return firstTime.IsZero(), false
+		}
+
+		return firstTime.After(secondTime), false
+	}
+
+	return false, true
+}
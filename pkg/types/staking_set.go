@@ -0,0 +1,81 @@
+package types
+
+import (
+	"math/big"
+	"sort"
+)
+
+// StakingSetCutoffMargin is how many validators right below the active set are considered
+// to be just outside of it, as they can enter it with a relatively small delegation.
+const StakingSetCutoffMargin = 5
+
+type StakingSetValidator struct {
+	ChainValidator ChainValidator
+	Rank           int
+	IsActive       bool
+	IsNearCutoff   bool
+	// TokensToEnter is how many tokens the validator lacks to have more than the last one
+	// in the active set, nil if it's already in the active set or there is no active set.
+	TokensToEnter *big.Int
+}
+
+type StakingSet []StakingSetValidator
+
+// GetStakingSet returns all validators sorted by their tokens, marking the active set
+// and the ones just outside of it.
+func (c ChainValidators) GetStakingSet() StakingSet {
+	validators := make(ChainValidators, len(c))
+	copy(validators, c)
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return getTokens(validators[i]).Cmp(getTokens(validators[j])) > 0
+	})
+
+	var lowestActiveTokens *big.Int
+	for _, validator := range validators {
+		if validator.Status != ValidatorStatusBonded {
+			continue
+		}
+
+		tokens := getTokens(validator)
+		if lowestActiveTokens == nil || tokens.Cmp(lowestActiveTokens) < 0 {
+			lowestActiveTokens = tokens
+		}
+	}
+
+	stakingSet := make(StakingSet, len(validators))
+	nearCutoffCount := 0
+
+	for index, validator := range validators {
+		stakingSetValidator := StakingSetValidator{
+			ChainValidator: validator,
+			Rank:           index + 1,
+			IsActive:       validator.Status == ValidatorStatusBonded,
+		}
+
+		// Jailed validators cannot enter the active set until unjailed, whatever their tokens are.
+		if !stakingSetValidator.IsActive && !validator.Jailed && lowestActiveTokens != nil {
+			if nearCutoffCount < StakingSetCutoffMargin {
+				stakingSetValidator.IsNearCutoff = true
+				nearCutoffCount++
+			}
+
+			tokensToEnter := new(big.Int).Sub(lowestActiveTokens, getTokens(validator))
+			if tokensToEnter.Sign() >= 0 {
+				stakingSetValidator.TokensToEnter = tokensToEnter.Add(tokensToEnter, big.NewInt(1))
+			}
+		}
+
+		stakingSet[index] = stakingSetValidator
+	}
+
+	return stakingSet
+}
+
+func getTokens(validator ChainValidator) *big.Int {
+	if validator.Tokens == nil {
+		return big.NewInt(0)
+	}
+
+	return validator.Tokens
+}
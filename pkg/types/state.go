@@ -3,11 +3,21 @@ package types
 import (
 	"fmt"
 	"main/pkg/utils"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+// NodeLagWarningBlocks is how many blocks the node's latest block can be behind
+// the consensus height before it's highlighted.
+const NodeLagWarningBlocks = 2
+
+// State is updated by the refresh goroutines while being read by the display,
+// so writers should hold its lock and readers should hold its read lock.
 type State struct {
+	sync.RWMutex
+
 	Height                       int64
 	Round                        int64
 	Step                         int64
@@ -15,18 +25,35 @@ type State struct {
 	ValidatorsWithAllRoundsVotes *ValidatorsWithAllRoundsVotes
 	ChainValidators              *ChainValidators
 	NodeStatus                   *TendermintStatusResult
+	NodeSyncInfo                 *TendermintSyncInfo
+	NetInfo                      *TendermintNetInfoResult
+	Mempool                      *TendermintUnconfirmedTxsResult
 	StartTime                    time.Time
 	Upgrade                      *Upgrade
 	BlockTime                    time.Duration
-
-	ConsensusStateError  error
-	ValidatorsError      error
-	ChainValidatorsError error
-	UpgradePlanError     error
-	StatusError          error
+	RPCEndpoints                 RPCEndpoints
+	BlocksHistory                *BlocksHistory
+	DumpConsensusState           *DumpConsensusStateRoundState
+	Peers                        []DumpConsensusStatePeer
+	StepTimings                  StepTimings
+	ProposerPriorities           []TendermintValidator
+	ProposerPrioritiesHeight     int64
+	ProposersSchedule            ProposersSchedule
+	MyValidators                 MyValidators
+	Replay                       *ReplayStatus
+
+	ConsensusStateError     error
+	ValidatorsError         error
+	ChainValidatorsError    error
+	UpgradePlanError        error
+	StatusError             error
+	NodeSyncInfoError       error
+	NetInfoError            error
+	MempoolError            error
+	DumpConsensusStateError error
 }
 
-func NewState() *State {
+func NewState(myValidators MyValidators) *State {
 	return &State{
 		Height:          0,
 		Round:           0,
@@ -35,6 +62,7 @@ func NewState() *State {
 		ChainValidators: nil,
 		StartTime:       time.Now(),
 		BlockTime:       0,
+		MyValidators:    myValidators,
 	}
 }
 
@@ -48,6 +76,7 @@ func (s *State) SetTendermintResponse(
 	s.Round = utils.MustParseInt64(hrsSplit[1])
 	s.Step = utils.MustParseInt64(hrsSplit[2])
 	s.StartTime = consensus.Result.RoundState.StartTime
+	s.StepTimings = s.StepTimings.WithStep(s.Height, s.Round, s.Step, s.Now())
 
 	validators, err := ValidatorsWithLatestRoundFromTendermintResponse(consensus, tendermintValidators, s.Round)
 	if err != nil {
@@ -66,6 +95,74 @@ func (s *State) SetTendermintResponse(
 	return nil
 }
 
+func (s *State) SetRoundStep(height, round, step int64) bool {
+	if height != s.Height || round != s.Round {
+		return false
+	}
+
+	s.Step = step
+	s.StepTimings = s.StepTimings.WithStep(s.Height, s.Round, s.Step, s.Now())
+	return true
+}
+
+func (s *State) AddVote(vote TendermintVote) bool {
+	height, err := strconv.ParseInt(vote.Height, 10, 64)
+	if err != nil || height != s.Height {
+		return false
+	}
+
+	if s.ValidatorsWithAllRoundsVotes == nil ||
+		vote.Round < 0 ||
+		vote.Round >= int64(len(s.ValidatorsWithAllRoundsVotes.RoundsVotes)) {
+		return false
+	}
+
+	// Copying the votes so the tables would see the change and redraw themselves.
+	roundsVotes := make([]RoundVotes, len(s.ValidatorsWithAllRoundsVotes.RoundsVotes))
+	copy(roundsVotes, s.ValidatorsWithAllRoundsVotes.RoundsVotes)
+
+	roundVotes := make(RoundVotes, len(roundsVotes[vote.Round]))
+	copy(roundVotes, roundsVotes[vote.Round])
+
+	if vote.ValidatorIndex < 0 ||
+		vote.ValidatorIndex >= len(roundVotes) ||
+		roundVotes[vote.ValidatorIndex].Address != vote.ValidatorAddress {
+		return false
+	}
+
+	switch vote.Type {
+	case VoteTypePrevote:
+		roundVotes[vote.ValidatorIndex].Prevote = vote.ToVote()
+		roundVotes[vote.ValidatorIndex].RawPrevote = vote.ToConsensusVote()
+		roundVotes[vote.ValidatorIndex].PrevoteTime = vote.Timestamp
+		roundVotes[vote.ValidatorIndex].PrevoteHash = vote.GetBlockHash()
+	case VoteTypePrecommit:
+		roundVotes[vote.ValidatorIndex].Precommit = vote.ToVote()
+		roundVotes[vote.ValidatorIndex].RawPrecommit = vote.ToConsensusVote()
+		roundVotes[vote.ValidatorIndex].PrecommitTime = vote.Timestamp
+		roundVotes[vote.ValidatorIndex].PrecommitHash = vote.GetBlockHash()
+	default:
+		return false
+	}
+
+	roundsVotes[vote.Round] = roundVotes
+	s.ValidatorsWithAllRoundsVotes = &ValidatorsWithAllRoundsVotes{
+		Validators:  s.ValidatorsWithAllRoundsVotes.Validators,
+		RoundsVotes: roundsVotes,
+	}
+
+	if vote.Round != s.Round || s.Validators == nil || vote.ValidatorIndex >= len(*s.Validators) {
+		return true
+	}
+
+	validators := make(ValidatorsWithRoundVote, len(*s.Validators))
+	copy(validators, *s.Validators)
+	validators[vote.ValidatorIndex].RoundVote = roundVotes[vote.ValidatorIndex]
+	s.Validators = &validators
+
+	return true
+}
+
 func (s *State) SetChainValidators(validators *ChainValidators) {
 	s.ChainValidators = validators
 }
@@ -74,6 +171,20 @@ func (s *State) SetNodeStatus(status *TendermintStatusResult) {
 	s.NodeStatus = status
 }
 
+// SetNodeSyncInfo stores the node's sync info, which is refetched with the node health
+// and is way fresher than the one in the node status.
+func (s *State) SetNodeSyncInfo(syncInfo *TendermintSyncInfo) {
+	s.NodeSyncInfo = syncInfo
+}
+
+func (s *State) SetNetInfo(netInfo *TendermintNetInfoResult) {
+	s.NetInfo = netInfo
+}
+
+func (s *State) SetMempool(mempool *TendermintUnconfirmedTxsResult) {
+	s.Mempool = mempool
+}
+
 func (s *State) SetUpgrade(upgrade *Upgrade) {
 	s.Upgrade = upgrade
 }
@@ -82,6 +193,61 @@ func (s *State) SetBlockTime(blockTime time.Duration) {
 	s.BlockTime = blockTime
 }
 
+func (s *State) SetRPCEndpoints(endpoints RPCEndpoints) {
+	s.RPCEndpoints = endpoints
+}
+
+func (s *State) SetBlocksHistory(history *BlocksHistory) {
+	s.BlocksHistory = history
+}
+
+// GetMyValidators returns the validators configured by user, along with the node's own validator
+// if the node is a validator itself.
+func (s *State) GetMyValidators() MyValidators {
+	if s.NodeStatus == nil || s.NodeStatus.ValidatorInfo.Address == "" {
+		return s.MyValidators
+	}
+
+	myValidators := make(MyValidators, len(s.MyValidators), len(s.MyValidators)+1)
+	copy(myValidators, s.MyValidators)
+
+	return append(myValidators, MyValidator{Address: s.NodeStatus.ValidatorInfo.Address})
+}
+
+// Now returns the current time, or the time of the recording when replaying.
+func (s *State) Now() time.Time {
+	if s.Replay != nil {
+		return s.Replay.Time
+	}
+
+	return time.Now()
+}
+
+func (s *State) SetReplayStatus(status *ReplayStatus) {
+	s.Replay = status
+}
+
+func (s *State) SetProposerPriorities(height int64, validators []TendermintValidator) {
+	s.ProposerPriorities = validators
+	s.ProposerPrioritiesHeight = height
+}
+
+func (s *State) SetProposersSchedule(schedule ProposersSchedule) {
+	s.ProposersSchedule = schedule
+}
+
+func (s *State) SetDumpConsensusState(roundState *DumpConsensusStateRoundState) {
+	s.DumpConsensusState = roundState
+}
+
+func (s *State) SetPeers(peers []DumpConsensusStatePeer) {
+	s.Peers = peers
+}
+
+func (s *State) SetDumpConsensusStateError(err error) {
+	s.DumpConsensusStateError = err
+}
+
 func (s *State) SetConsensusStateError(err error) {
 	s.ConsensusStateError = err
 }
@@ -90,31 +256,59 @@ func (s *State) SetValidatorsError(err error) {
 	s.ValidatorsError = err
 }
 
+func (s *State) SetChainValidatorsError(err error) {
+	s.ChainValidatorsError = err
+}
+
 func (s *State) SetUpgradePlanError(err error) {
 	s.UpgradePlanError = err
 }
 
+func (s *State) SetNetInfoError(err error) {
+	s.NetInfoError = err
+}
+
+func (s *State) SetMempoolError(err error) {
+	s.MempoolError = err
+}
+
 func (s *State) SetStatusError(err error) {
 	s.StatusError = err
 }
 
+func (s *State) SetNodeSyncInfoError(err error) {
+	s.NodeSyncInfoError = err
+}
+
 func (s *State) SerializeConsensus(timezone *time.Location) string {
 	if s.ConsensusStateError != nil {
 		return fmt.Sprintf(" consensus state error: %s", s.ConsensusStateError)
 	}
 
-	if s.Validators == nil {
-		return ""
+	var sb strings.Builder
+
+	if s.Replay != nil {
+		sb.WriteString(s.Replay.Serialize(timezone))
 	}
 
-	var sb strings.Builder
+	if s.Validators == nil {
+		return sb.String()
+	}
 
-	sb.WriteString(fmt.Sprintf(" height=%d round=%d step=%d\n", s.Height, s.Round, s.Step))
+	sb.WriteString(fmt.Sprintf(
+		" height=%d round=%d step=%d (%s)\n",
+		s.Height,
+		s.Round,
+		s.Step,
+		RoundStepName(s.Step),
+	))
+	sb.WriteString(fmt.Sprintf(" steps: %s\n", s.StepTimings.Serialize()))
 	sb.WriteString(fmt.Sprintf(
 		" block time: %s (%s)\n",
-		utils.ZeroOrPositiveDuration(utils.SerializeDuration(time.Since(s.StartTime))),
+		utils.ZeroOrPositiveDuration(utils.SerializeDuration(s.Now().Sub(s.StartTime))),
 		utils.SerializeTime(s.StartTime.In(timezone)),
 	))
+	sb.WriteString(s.SerializeMyValidators())
 	sb.WriteString(fmt.Sprintf(
 		" prevote consensus (total/agreeing): %.2f / %.2f\n",
 		s.Validators.GetTotalVotingPowerPrevotedPercent(true),
@@ -161,7 +355,7 @@ func (s *State) SerializeConsensus(timezone *time.Location) string {
 		len(*s.Validators),
 	))
 
-	sb.WriteString(fmt.Sprintf(" last updated at: %s\n", utils.SerializeTime(time.Now().In(timezone))))
+	sb.WriteString(fmt.Sprintf(" last updated at: %s\n", utils.SerializeTime(s.Now().In(timezone))))
 
 	return sb.String()
 }
@@ -191,6 +385,71 @@ func (s *State) SerializeChainInfo(timezone *time.Location) string {
 	return sb.String()
 }
 
+// SerializeNodeHealth returns the info about the RPC node itself, to tell whether the node
+// or the whole chain is stuck.
+func (s *State) SerializeNodeHealth() string {
+	var sb strings.Builder
+
+	if s.StatusError != nil {
+		sb.WriteString(fmt.Sprintf(" node status fetch error: %s\n", s.StatusError.Error()))
+	} else if s.NodeSyncInfoError != nil {
+		sb.WriteString(fmt.Sprintf(" node sync info fetch error: %s\n", s.NodeSyncInfoError.Error()))
+	} else if s.NodeStatus != nil {
+		nodeInfo, syncInfo := s.NodeStatus.NodeInfo, s.NodeStatus.SyncInfo
+		if s.NodeSyncInfo != nil {
+			syncInfo = *s.NodeSyncInfo
+		}
+
+		if syncInfo.CatchingUp {
+			sb.WriteString(fmt.Sprintf(" node: %s [red](catching up)[-]\n", nodeInfo.Moniker))
+		} else {
+			sb.WriteString(fmt.Sprintf(" node: %s (synced)\n", nodeInfo.Moniker))
+		}
+
+		sb.WriteString(fmt.Sprintf(" id: %s\n", nodeInfo.ID))
+		sb.WriteString(fmt.Sprintf(" listen address: %s\n", nodeInfo.ListenAddr))
+		sb.WriteString(fmt.Sprintf(
+			" latest block: %s, %s ago",
+			syncInfo.LatestBlockHeight,
+			utils.SerializeDuration(s.Now().Sub(syncInfo.LatestBlockTime)),
+		))
+
+		// Consensus is working on the height after the latest block, so it's 1 block ahead normally.
+		latestBlockHeight, err := strconv.ParseInt(syncInfo.LatestBlockHeight, 10, 64)
+		if err == nil && s.Height > 0 {
+			lag := max(s.Height-1-latestBlockHeight, 0)
+			if lag >= NodeLagWarningBlocks {
+				sb.WriteString(fmt.Sprintf(" [red](lag: %d blocks)[-]", lag))
+			} else {
+				sb.WriteString(fmt.Sprintf(" (lag: %d blocks)", lag))
+			}
+		}
+
+		sb.WriteString("\n")
+	}
+
+	if s.NetInfoError != nil {
+		sb.WriteString(fmt.Sprintf(" peers fetch error: %s\n", s.NetInfoError.Error()))
+	} else if s.NetInfo != nil {
+		inbound, outbound := s.NetInfo.GetPeersCount()
+		text := fmt.Sprintf("peers: %d (%d inbound, %d outbound)", len(s.NetInfo.Peers), inbound, outbound)
+
+		if len(s.NetInfo.Peers) == 0 {
+			sb.WriteString(" [red]" + text + "[-]\n")
+		} else {
+			sb.WriteString(" " + text + "\n")
+		}
+	}
+
+	if s.MempoolError != nil {
+		sb.WriteString(fmt.Sprintf(" mempool fetch error: %s\n", s.MempoolError.Error()))
+	} else if s.Mempool != nil {
+		sb.WriteString(fmt.Sprintf(" mempool: %s txs, %s bytes\n", s.Mempool.Total, s.Mempool.TotalBytes))
+	}
+
+	return sb.String()
+}
+
 func (s *State) SerializeUpgradeInfo(timezone *time.Location) string {
 	var sb strings.Builder
 
@@ -252,6 +511,287 @@ func (s *State) SerializeUpgradeInfo(timezone *time.Location) string {
 	return sb.String()
 }
 
+func (s *State) SerializeProposalInfo() string {
+	if s.DumpConsensusStateError != nil {
+		return fmt.Sprintf(" round state fetch error: %s\n", s.DumpConsensusStateError)
+	}
+
+	roundState := s.DumpConsensusState
+	if roundState == nil {
+		return ""
+	}
+
+	blockHashesVotes := s.GetPrevotesByBlockHash()
+	serializeHash := func(hash string) string {
+		shortHash := ShortHash(hash)
+		if color := blockHashesVotes.GetColor(shortHash); color != "" {
+			return fmt.Sprintf("[%s]%s[-]", color, shortHash)
+		}
+
+		return shortHash
+	}
+
+	// Votes of older rounds might be pruned already, so the hash might not be known.
+	serializePolkaHash := func(round int64) string {
+		if hash := roundState.GetPolkaBlockHash(round); hash != "" {
+			return serializeHash(hash)
+		}
+
+		return "unknown block"
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf(" round state at %s/%d\n", roundState.Height, roundState.Round))
+
+	proposal := roundState.Proposal
+	if proposal == nil {
+		sb.WriteString(" proposal: not received yet\n")
+	} else {
+		sb.WriteString(fmt.Sprintf(
+			" proposal: received after %s\n",
+			utils.SerializeDuration(proposal.Timestamp.Sub(s.StartTime)),
+		))
+		sb.WriteString(fmt.Sprintf(
+			" block: %s (POL round %d)\n",
+			serializeHash(proposal.BlockID.Hash),
+			proposal.POLRound,
+		))
+	}
+
+	if blockParts, ok := roundState.GetBlockParts(); ok {
+		sb.WriteString(fmt.Sprintf(" block parts received: %s\n", blockParts))
+	}
+
+	if roundState.LockedRound < 0 {
+		sb.WriteString(" locked: not locked\n")
+	} else {
+		sb.WriteString(fmt.Sprintf(
+			" locked: %s at round %d\n",
+			serializePolkaHash(roundState.LockedRound),
+			roundState.LockedRound,
+		))
+	}
+
+	if roundState.ValidRound >= 0 {
+		sb.WriteString(fmt.Sprintf(
+			" valid: %s at round %d\n",
+			serializePolkaHash(roundState.ValidRound),
+			roundState.ValidRound,
+		))
+	}
+
+	if roundState.LastCommit != nil {
+		signed, total := roundState.LastCommit.GetSignaturesCount()
+		sb.WriteString(fmt.Sprintf(" last commit signatures: %d/%d\n", signed, total))
+	}
+
+	sb.WriteString(s.SerializeNextProposals())
+
+	return sb.String()
+}
+
+func (s *State) SerializeMyValidators() string {
+	var sb strings.Builder
+
+	validators := s.GetValidatorsWithInfo()
+	myValidators := s.GetMyValidators()
+
+	for _, myValidator := range myValidators {
+		validator, found := utils.Find(validators, func(v ValidatorWithInfo) bool {
+			return v.IsMine(MyValidators{myValidator})
+		})
+
+		if !found {
+			sb.WriteString(fmt.Sprintf(
+				" [red]my validator: %s is not in the active set[-]\n",
+				myValidator.GetName(),
+			))
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf(
+			" my validator: %s (#%d, %.2f%%) prevote: %s, precommit: %s",
+			validator.GetName(),
+			validator.Validator.Index+1,
+			validator.Validator.VotingPowerPercent,
+			validator.RoundVote.Prevote,
+			validator.RoundVote.Precommit,
+		))
+
+		if s.BlocksHistory != nil && len(s.BlocksHistory.Blocks) > 0 {
+			sb.WriteString(fmt.Sprintf(
+				", missed %d/%d blocks",
+				s.BlocksHistory.GetMissedCount(validator.Validator.Address),
+				len(s.BlocksHistory.Blocks),
+			))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func (s *State) SerializeNextProposals() string {
+	if len(s.ProposersSchedule) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	validators := s.GetValidatorsWithInfo()
+	nextProposer := s.ProposersSchedule[0]
+	name := nextProposer.Address
+
+	validator, found := utils.Find(validators, func(v ValidatorWithInfo) bool {
+		return v.Validator.Address == nextProposer.Address
+	})
+	if found {
+		name = validator.GetName()
+	}
+
+	sb.WriteString(fmt.Sprintf(
+		" next proposer: %s at %d/%d\n",
+		name,
+		nextProposer.Height,
+		nextProposer.Round,
+	))
+
+	myValidators := s.GetMyValidators()
+	if len(myValidators) == 0 {
+		return sb.String()
+	}
+
+	ownProposal, found := s.ProposersSchedule.GetNextProposal(func(address string) bool {
+		validator, found := utils.Find(validators, func(v ValidatorWithInfo) bool {
+			return v.Validator.Address == address
+		})
+
+		return found && validator.IsMine(myValidators)
+	})
+	switch {
+	case !found:
+		sb.WriteString(" our next proposal: not scheduled soon\n")
+	case ownProposal.Height > s.Height && s.BlockTime != 0:
+		sb.WriteString(fmt.Sprintf(
+			" [green]our next proposal: %d/%d (in ~%s)[-]\n",
+			ownProposal.Height,
+			ownProposal.Round,
+			utils.SerializeDuration(time.Duration(ownProposal.Height-s.Height)*s.BlockTime),
+		))
+	default:
+		sb.WriteString(fmt.Sprintf(
+			" [green]our next proposal: %d/%d[-]\n",
+			ownProposal.Height,
+			ownProposal.Round,
+		))
+	}
+
+	return sb.String()
+}
+
+func (s *State) SerializeRPCEndpoints() string {
+	if len(s.RPCEndpoints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" RPC endpoints: %s", s.RPCEndpoints.Serialize())
+}
+
+func (s *State) SerializeValidatorInfo(index int, disableEmojis bool) string {
+	if s.ValidatorsWithAllRoundsVotes == nil ||
+		index < 0 ||
+		index >= len(s.ValidatorsWithAllRoundsVotes.Validators) {
+		return " validator is not found\n"
+	}
+
+	validator := s.ValidatorsWithAllRoundsVotes.Validators[index]
+
+	var chainValidator *ChainValidator
+	if s.ChainValidators != nil {
+		if found, ok := s.ChainValidators.ToMap()[validator.Address]; ok {
+			chainValidator = &found
+		}
+	}
+
+	var sb strings.Builder
+
+	if chainValidator != nil {
+		sb.WriteString(fmt.Sprintf(" moniker: %s\n", chainValidator.Moniker))
+	}
+
+	sb.WriteString(fmt.Sprintf(" index: %d\n", validator.Index+1))
+	sb.WriteString(fmt.Sprintf(" hex address: %s\n", validator.Address))
+
+	if chainValidator != nil {
+		sb.WriteString(fmt.Sprintf(" bech32 address: %s\n", chainValidator.RawAddress))
+
+		if chainValidator.AssignedAddress != "" {
+			sb.WriteString(fmt.Sprintf(" provider hex address: %s\n", chainValidator.Address))
+			sb.WriteString(fmt.Sprintf(
+				" assigned consumer key: %s (%s)\n",
+				chainValidator.RawAssignedAddress,
+				chainValidator.AssignedAddress,
+			))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf(
+		" voting power: %s (%.2f%%)\n",
+		validator.VotingPower,
+		validator.VotingPowerPercent,
+	))
+
+	if chainValidator != nil && chainValidator.SigningInfo != nil {
+		sb.WriteString(chainValidator.SigningInfo.SerializeDetails(s.Now()))
+	}
+
+	isProposer := false
+	if round := s.Round; round >= 0 && round < int64(len(s.ValidatorsWithAllRoundsVotes.RoundsVotes)) {
+		roundVotes := s.ValidatorsWithAllRoundsVotes.RoundsVotes[round]
+		isProposer = index < len(roundVotes) && roundVotes[index].IsProposer
+	}
+
+	sb.WriteString(fmt.Sprintf(" is proposer in current round: %t\n", isProposer))
+	sb.WriteString(fmt.Sprintf("\n votes at height %d:\n", s.Height))
+
+	for round, roundVotes := range s.ValidatorsWithAllRoundsVotes.RoundsVotes {
+		if index >= len(roundVotes) {
+			continue
+		}
+
+		roundVote := roundVotes[index]
+
+		sb.WriteString(fmt.Sprintf(
+			" round %d: prevote %s%s precommit %s%s\n",
+			round,
+			roundVote.Prevote.Serialize(disableEmojis),
+			serializeVoteLatency(roundVote.GetPrevoteLatency(s.StartTime)),
+			roundVote.Precommit.Serialize(disableEmojis),
+			serializeVoteLatency(roundVote.GetPrecommitLatency(s.StartTime)),
+		))
+
+		if roundVote.RawPrevote != "" {
+			sb.WriteString(fmt.Sprintf("   %s\n", roundVote.RawPrevote))
+		}
+
+		if roundVote.RawPrecommit != "" {
+			sb.WriteString(fmt.Sprintf("   %s\n", roundVote.RawPrecommit))
+		}
+	}
+
+	return sb.String()
+}
+
+func serializeVoteLatency(latency time.Duration, ok bool) string {
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(" (after %s)", utils.SerializeDuration(latency))
+}
+
 func (s *State) SerializeProgressbar(width int, height int, prefix string, progress int) string {
 	progressBar := ProgressBar{
 		Width:    width,
@@ -275,6 +815,18 @@ func (s *State) SerializePrevotesProgressbar(width int, height int) string {
 	return s.SerializeProgressbar(width, height, "Prevotes: ", prevotePercentInt)
 }
 
+func (s *State) GetPrevotesByBlockHash() BlockHashesVotes {
+	if s.Validators == nil {
+		return BlockHashesVotes{}
+	}
+
+	return s.Validators.GetPrevotesByBlockHash()
+}
+
+func (s *State) SerializePrevotesByBlockHash() string {
+	return s.GetPrevotesByBlockHash().Serialize()
+}
+
 func (s *State) SerializePrecommitsProgressbar(width int, height int) string {
 	if s.Validators == nil {
 		return ""
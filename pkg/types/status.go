@@ -1,17 +1,29 @@
 package types
 
+import "time"
+
 type TendermintStatusResponse struct {
 	Result TendermintStatusResult `json:"result"`
 }
 
 type TendermintStatusResult struct {
 	NodeInfo      TendermintNodeInfo      `json:"node_info"`
+	SyncInfo      TendermintSyncInfo      `json:"sync_info"`
 	ValidatorInfo TendermintValidatorInfo `json:"validator_info"`
 }
 
 type TendermintNodeInfo struct {
-	Version string `json:"version"`
-	Network string `json:"network"`
+	ID         string `json:"id"`
+	ListenAddr string `json:"listen_addr"`
+	Version    string `json:"version"`
+	Network    string `json:"network"`
+	Moniker    string `json:"moniker"`
+}
+
+type TendermintSyncInfo struct {
+	LatestBlockHeight string    `json:"latest_block_height"`
+	LatestBlockTime   time.Time `json:"latest_block_time"`
+	CatchingUp        bool      `json:"catching_up"`
 }
 
 type TendermintValidatorInfo struct {
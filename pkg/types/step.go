@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"strings"
+	"time"
+)
+
+const (
+	RoundStepNewHeight     int64 = 1
+	RoundStepNewRound      int64 = 2
+	RoundStepPropose       int64 = 3
+	RoundStepPrevote       int64 = 4
+	RoundStepPrevoteWait   int64 = 5
+	RoundStepPrecommit     int64 = 6
+	RoundStepPrecommitWait int64 = 7
+	RoundStepCommit        int64 = 8
+)
+
+var roundSteps = map[string]int64{
+	"RoundStepNewHeight":     RoundStepNewHeight,
+	"RoundStepNewRound":      RoundStepNewRound,
+	"RoundStepPropose":       RoundStepPropose,
+	"RoundStepPrevote":       RoundStepPrevote,
+	"RoundStepPrevoteWait":   RoundStepPrevoteWait,
+	"RoundStepPrecommit":     RoundStepPrecommit,
+	"RoundStepPrecommitWait": RoundStepPrecommitWait,
+	"RoundStepCommit":        RoundStepCommit,
+}
+
+func RoundStepFromString(step string) (int64, error) {
+	if value, ok := roundSteps[step]; ok {
+		return value, nil
+	}
+
+	return 0, fmt.Errorf("unknown round step: %s", step)
+}
+
+// RoundStepName returns the step name the same way CometBFT names it, without the RoundStep prefix.
+func RoundStepName(step int64) string {
+	for name, value := range roundSteps {
+		if value == step {
+			return strings.TrimPrefix(name, "RoundStep")
+		}
+	}
+
+	return fmt.Sprintf("Unknown(%d)", step)
+}
+
+type StepTiming struct {
+	Step      int64
+	StartedAt time.Time
+}
+
+// StepTimings tracks when each step of the round was first seen, to display how much time
+// was spent in each of them. As it's based on the refreshes, the time is only as precise
+// as the refresh rate (or the websocket events, if enabled).
+type StepTimings struct {
+	Height int64
+	Round  int64
+	Steps  []StepTiming
+}
+
+// WithStep returns the timings with the step added if it differs from the latest one,
+// or new timings if the height or round has changed.
+func (t StepTimings) WithStep(height, round, step int64, seenAt time.Time) StepTimings {
+	if t.Height != height || t.Round != round {
+		return StepTimings{
+			Height: height,
+			Round:  round,
+			Steps:  []StepTiming{{Step: step, StartedAt: seenAt}},
+		}
+	}
+
+	if len(t.Steps) > 0 && t.Steps[len(t.Steps)-1].Step == step {
+		return t
+	}
+
+	steps := make([]StepTiming, len(t.Steps), len(t.Steps)+1)
+	copy(steps, t.Steps)
+
+	return StepTimings{
+		Height: height,
+		Round:  round,
+		Steps:  append(steps, StepTiming{Step: step, StartedAt: seenAt}),
+	}
+}
+
+func (t StepTimings) Serialize() string {
+	serialized := make([]string, len(t.Steps))
+
+	for index, step := range t.Steps {
+		finishedAt := time.Now()
+		if index+1 < len(t.Steps) {
+			finishedAt = t.Steps[index+1].StartedAt
+		}
+
+		serialized[index] = fmt.Sprintf(
+			"%s %s",
+			RoundStepName(step.Step),
+			utils.SerializeDuration(finishedAt.Sub(step.StartedAt).Truncate(time.Millisecond)),
+		)
+	}
+
+	return strings.Join(serialized, " → ")
+}
@@ -1,16 +1,164 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
 type DumpConsensusStateResponse struct {
 	Result *DumpConsensusStateResult `json:"result"`
 }
 
 type DumpConsensusStateResult struct {
 	RoundState *DumpConsensusStateRoundState `json:"round_state"`
+	Peers      []DumpConsensusStatePeer      `json:"peers"`
 }
 
 type DumpConsensusStateRoundState struct {
-	Validators DumpConsensusStateRoundStateValidators `json:"validators"`
+	Height                   string                                 `json:"height"`
+	Round                    int64                                  `json:"round"`
+	Step                     int64                                  `json:"step"`
+	Validators               DumpConsensusStateRoundStateValidators `json:"validators"`
+	Proposal                 *ConsensusProposal                     `json:"proposal"`
+	ProposalBlockParts       *ConsensusPartSet                      `json:"proposal_block_parts"`
+	ProposalBlockPartsHeader *ConsensusPartSetHeader                `json:"proposal_block_parts_header"`
+	LockedRound              int64                                  `json:"locked_round"`
+	ValidRound               int64                                  `json:"valid_round"`
+	Votes                    []ConsensusHeightVoteSet               `json:"votes"`
+	LastCommit               *ConsensusVoteSet                      `json:"last_commit"`
 }
+
 type DumpConsensusStateRoundStateValidators struct {
 	Validators []TendermintValidator `json:"validators"`
 }
+
+type ConsensusProposal struct {
+	Height    string           `json:"height"`
+	Round     int64            `json:"round"`
+	POLRound  int64            `json:"pol_round"`
+	BlockID   ConsensusBlockID `json:"block_id"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+type ConsensusBlockID struct {
+	Hash  string                 `json:"hash"`
+	Parts ConsensusPartSetHeader `json:"parts"`
+}
+
+type ConsensusPartSetHeader struct {
+	Total int64  `json:"total"`
+	Hash  string `json:"hash"`
+}
+
+// ConsensusPartSet is the set of the block parts received so far,
+// serialized as {"count/total": "1/2", "parts_bit_array": "BA{2:x_}"}.
+type ConsensusPartSet struct {
+	CountTotal    string `json:"count/total"`
+	PartsBitArray string `json:"parts_bit_array"`
+}
+
+type ConsensusVoteSet struct {
+	Votes         []ConsensusVote `json:"votes"`
+	VotesBitArray string          `json:"votes_bit_array"`
+}
+
+// GetBlockParts returns the amount of the proposal block parts received and the total amount of them.
+func (s DumpConsensusStateRoundState) GetBlockParts() (string, bool) {
+	if s.ProposalBlockParts != nil && s.ProposalBlockParts.CountTotal != "" {
+		return s.ProposalBlockParts.CountTotal, true
+	}
+
+	if s.ProposalBlockPartsHeader != nil && s.ProposalBlockPartsHeader.Total > 0 {
+		return fmt.Sprintf("?/%d", s.ProposalBlockPartsHeader.Total), true
+	}
+
+	return "", false
+}
+
+// GetPolkaBlockHash returns the short hash of the block that got more than 2/3 of the voting power
+// prevoting for it in the given round, or an empty string if there's no such block. A validator
+// locks on (or considers valid) the block that got these prevotes in its locked (or valid) round,
+// so this is the locked (or valid) block hash, taken from the same response as the rounds are.
+func (s DumpConsensusStateRoundState) GetPolkaBlockHash(round int64) string {
+	if round < 0 {
+		return ""
+	}
+
+	var roundVotes *ConsensusHeightVoteSet
+	for index := range s.Votes {
+		if int64(s.Votes[index].Round) == round {
+			roundVotes = &s.Votes[index]
+			break
+		}
+	}
+
+	if roundVotes == nil {
+		return ""
+	}
+
+	validators := s.Validators.Validators
+	votingPowers := make([]*big.Int, len(validators))
+	totalVP := big.NewInt(0)
+
+	for index, validator := range validators {
+		vp, ok := new(big.Int).SetString(validator.VotingPower, 10)
+		if !ok {
+			return ""
+		}
+
+		votingPowers[index] = vp
+		totalVP.Add(totalVP, vp)
+	}
+
+	votesByHash := make(map[string]*big.Int)
+
+	for _, vote := range roundVotes.Prevotes {
+		parsed, err := vote.Parse()
+		if err != nil || parsed == nil || !parsed.IsForBlock() || parsed.ValidatorIndex >= len(votingPowers) {
+			continue
+		}
+
+		if _, ok := votesByHash[parsed.BlockHash]; !ok {
+			votesByHash[parsed.BlockHash] = big.NewInt(0)
+		}
+
+		votesByHash[parsed.BlockHash].Add(votesByHash[parsed.BlockHash], votingPowers[parsed.ValidatorIndex])
+	}
+
+	// More than 2/3 means voted * 3 > total * 2, this way there's no rounding.
+	threshold := new(big.Int).Mul(totalVP, big.NewInt(2))
+
+	for hash, votingPower := range votesByHash {
+		if new(big.Int).Mul(votingPower, big.NewInt(3)).Cmp(threshold) > 0 {
+			return hash
+		}
+	}
+
+	return ""
+}
+
+// GetSignaturesCount returns the amount of validators that have signed the last commit
+// and the total amount of validators.
+func (s ConsensusVoteSet) GetSignaturesCount() (int, int) {
+	signed := 0
+
+	for _, vote := range s.Votes {
+		if vote != ConsensusVoteNil {
+			signed++
+		}
+	}
+
+	return signed, len(s.Votes)
+}
+
+// ShortHash returns the first 6 bytes of a hex-encoded hash, the same way
+// CometBFT displays them in votes.
+func ShortHash(hash string) string {
+	if hash == "" {
+		return ""
+	}
+
+	return fingerprint(strings.ToUpper(hash))
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	EventTypeVote             = "tendermint/event/Vote"
+	EventTypeRoundState       = "tendermint/event/RoundState"
+	EventTypeCompleteProposal = "tendermint/event/CompleteProposal"
+	EventTypeNewBlock         = "tendermint/event/NewBlock"
+)
+
+const (
+	VoteTypePrevote   = 1
+	VoteTypePrecommit = 2
+)
+
+type TendermintEventResponse struct {
+	Result *TendermintEventResult `json:"result"`
+	Error  *ValidatorsError       `json:"error"`
+}
+
+type TendermintEventResult struct {
+	Query string               `json:"query"`
+	Data  *TendermintEventData `json:"data"`
+}
+
+type TendermintEventData struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type TendermintEventVote struct {
+	Vote TendermintVote `json:"Vote"`
+}
+
+type TendermintVote struct {
+	Type             int               `json:"type"`
+	Height           string            `json:"height"`
+	Round            int64             `json:"round"`
+	BlockID          TendermintBlockID `json:"block_id"`
+	Timestamp        time.Time         `json:"timestamp"`
+	ValidatorAddress string            `json:"validator_address"`
+	ValidatorIndex   int               `json:"validator_index"`
+	Signature        string            `json:"signature"`
+}
+
+func (v TendermintVote) ToVote() Vote {
+	if v.BlockID.Hash == "" {
+		return VotedZero
+	}
+
+	return Voted
+}
+
+// GetBlockHash returns the block hash in the same format as the parsed votes have it,
+// or an empty string if it's a vote for nil.
+func (v TendermintVote) GetBlockHash() string {
+	if v.BlockID.Hash == "" {
+		return ""
+	}
+
+	return fingerprint(v.BlockID.Hash)
+}
+
+// ToConsensusVote converts the vote to the same format /consensus_state returns votes in,
+// so votes received via websocket and via polling are displayed the same way.
+func (v TendermintVote) ToConsensusVote() ConsensusVote {
+	voteType := "SIGNED_MSG_TYPE_PREVOTE(Prevote)"
+	if v.Type == VoteTypePrecommit {
+		voteType = "SIGNED_MSG_TYPE_PRECOMMIT(Precommit)"
+	}
+
+	signature, _ := base64.StdEncoding.DecodeString(v.Signature)
+
+	return ConsensusVote(fmt.Sprintf(
+		"Vote{%d:%s %s/%02d/%s %s %s @ %s}",
+		v.ValidatorIndex,
+		fingerprint(v.ValidatorAddress),
+		v.Height,
+		v.Round,
+		voteType,
+		fingerprint(v.BlockID.Hash),
+		fingerprint(fmt.Sprintf("%X", signature)),
+		v.Timestamp.Format(time.RFC3339Nano),
+	))
+}
+
+// fingerprint returns the first 6 bytes of a hex-encoded value, or zeroes if it's empty,
+// the same way CometBFT does when printing votes.
+func fingerprint(value string) string {
+	value = strings.ToUpper(value) + strings.Repeat("0", 12)
+	return value[:12]
+}
+
+type TendermintBlockID struct {
+	Hash string `json:"hash"`
+}
+
+type TendermintEventRoundState struct {
+	Height string `json:"height"`
+	Round  int64  `json:"round"`
+	Step   string `json:"step"`
+}
+
+type TendermintSubscribeRequest struct {
+	JSONRPC string                    `json:"jsonrpc"`
+	Method  string                    `json:"method"`
+	ID      int                       `json:"id"`
+	Params  TendermintSubscribeParams `json:"params"`
+}
+
+type TendermintSubscribeParams struct {
+	Query string `json:"query"`
+}
@@ -17,6 +17,7 @@ type ValidatorsResult struct {
 }
 
 type TendermintValidator struct {
-	Address     string `json:"address"`
-	VotingPower string `json:"voting_power"`
+	Address          string `json:"address"`
+	VotingPower      string `json:"voting_power"`
+	ProposerPriority string `json:"proposer_priority"`
 }
@@ -5,6 +5,8 @@ import (
 	"main/pkg/utils"
 	"math/big"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Validator struct {
@@ -17,10 +19,16 @@ type Validator struct {
 type Validators []Validator
 
 type RoundVote struct {
-	Address    string
-	Prevote    Vote
-	Precommit  Vote
-	IsProposer bool
+	Address       string
+	Prevote       Vote
+	Precommit     Vote
+	IsProposer    bool
+	RawPrevote    ConsensusVote
+	RawPrecommit  ConsensusVote
+	PrevoteTime   time.Time
+	PrecommitTime time.Time
+	PrevoteHash   string
+	PrecommitHash string
 }
 
 func (v RoundVote) Equals(other RoundVote) bool {
@@ -40,7 +48,15 @@ func (v RoundVote) Equals(other RoundVote) bool {
 		return false
 	}
 
-	return false
+	if v.PrevoteHash != other.PrevoteHash || v.PrecommitHash != other.PrecommitHash {
+		return false
+	}
+
+	if v.RawPrevote != other.RawPrevote || v.RawPrecommit != other.RawPrecommit {
+		return false
+	}
+
+	return true
 }
 func (v RoundVote) Serialize(disableEmojis bool) string {
 	return fmt.Sprintf(
@@ -50,6 +66,26 @@ func (v RoundVote) Serialize(disableEmojis bool) string {
 	)
 }
 
+// GetPrevoteLatency returns the time passed since the start of the height till the prevote,
+// or false if there's no prevote.
+func (v RoundVote) GetPrevoteLatency(startTime time.Time) (time.Duration, bool) {
+	if v.PrevoteTime.IsZero() {
+		return 0, false
+	}
+
+	return v.PrevoteTime.Sub(startTime), true
+}
+
+// GetPrecommitLatency returns the time passed since the start of the height till the precommit,
+// or false if there's no precommit.
+func (v RoundVote) GetPrecommitLatency(startTime time.Time) (time.Duration, bool) {
+	if v.PrecommitTime.IsZero() {
+		return 0, false
+	}
+
+	return v.PrecommitTime.Sub(startTime), true
+}
+
 type RoundVotes []RoundVote
 
 type ValidatorWithRoundVote struct {
@@ -125,7 +161,15 @@ type ValidatorWithInfo struct {
 	ChainValidator *ChainValidator
 }
 
-func (v ValidatorWithInfo) Serialize(disableEmojis bool) string {
+func (v ValidatorWithInfo) GetName() string {
+	if v.ChainValidator != nil {
+		return v.ChainValidator.Moniker
+	}
+
+	return v.Validator.Address
+}
+
+func (v ValidatorWithInfo) Serialize(disableEmojis bool, now time.Time) string {
 	name := v.Validator.Address
 	if v.ChainValidator != nil {
 		name = v.ChainValidator.Moniker
@@ -138,16 +182,35 @@ func (v ValidatorWithInfo) Serialize(disableEmojis bool) string {
 		}
 	}
 
+	uptime := ""
+	if v.ChainValidator != nil && v.ChainValidator.SigningInfo != nil {
+		uptime = v.ChainValidator.SigningInfo.Serialize(now) + " "
+		if status := v.ChainValidator.SigningInfo.SerializeStatus(now); status != "" {
+			uptime += status + " "
+		}
+	}
+
 	return fmt.Sprintf(
-		" %s %s %s %s%% %s ",
+		" %s %s %s %s%% %s %s",
 		v.RoundVote.Prevote.Serialize(disableEmojis),
 		v.RoundVote.Precommit.Serialize(disableEmojis),
 		utils.RightPadAndTrim(strconv.Itoa(v.Validator.Index+1), 3),
 		utils.RightPadAndTrim(fmt.Sprintf("%.2f", v.Validator.VotingPowerPercent), 6),
 		utils.LeftPadAndTrim(name, 25),
+		uptime,
 	)
 }
 
+// Matches returns true if the validator's moniker or any of its addresses contains the query,
+// case-insensitive. An empty query matches every validator.
+func (v ValidatorWithInfo) Matches(query string) bool {
+	return matchesValidator(v.Validator, v.ChainValidator, query)
+}
+
+func (v ValidatorWithInfo) IsMine(myValidators MyValidators) bool {
+	return isMyValidator(v.Validator, v.ChainValidator, myValidators)
+}
+
 type ValidatorsWithInfo []ValidatorWithInfo
 
 type ValidatorWithChainValidator struct {
@@ -195,6 +258,14 @@ func (v ValidatorWithChainValidator) Equals(other ValidatorWithChainValidator) b
 	return true
 }
 
+func (v ValidatorWithChainValidator) Matches(query string) bool {
+	return matchesValidator(v.Validator, v.ChainValidator, query)
+}
+
+func (v ValidatorWithChainValidator) IsMine(myValidators MyValidators) bool {
+	return isMyValidator(v.Validator, v.ChainValidator, myValidators)
+}
+
 func (v ValidatorWithChainValidator) Serialize() string {
 	name := v.Validator.Address
 	if v.ChainValidator != nil {
@@ -230,7 +301,7 @@ func (v ValidatorsWithInfoAndAllRoundVotes) Equals(other ValidatorsWithInfoAndAl
 
 		for innerIndex, roundVotes := range roundsVotes {
 			otherRoundVotes := otherRoundsVotes[innerIndex]
-			if roundVotes.Equals(otherRoundVotes) {
+			if !roundVotes.Equals(otherRoundVotes) {
 				return false
 			}
 		}
@@ -248,3 +319,61 @@ func (v ValidatorsWithInfoAndAllRoundVotes) Equals(other ValidatorsWithInfoAndAl
 
 	return true
 }
+
+func (v ValidatorsWithInfoAndAllRoundVotes) GetRoundValidators(round int) ValidatorsWithRoundVote {
+	if round < 0 || round >= len(v.RoundsVotes) {
+		return ValidatorsWithRoundVote{}
+	}
+
+	validators := make(ValidatorsWithRoundVote, 0, len(v.Validators))
+
+	for index, roundVote := range v.RoundsVotes[round] {
+		if index < len(v.Validators) {
+			validators = append(validators, ValidatorWithRoundVote{
+				Validator: v.Validators[index].Validator,
+				RoundVote: roundVote,
+			})
+		}
+	}
+
+	return validators
+}
+
+// GetLatestRoundVotes returns the votes of the latest round with at least one vote,
+// or the votes of the latest round if nobody has voted yet.
+func (v ValidatorsWithInfoAndAllRoundVotes) GetLatestRoundVotes() RoundVotes {
+	for index := len(v.RoundsVotes) - 1; index >= 0; index-- {
+		for _, roundVote := range v.RoundsVotes[index] {
+			if roundVote.Prevote != VotedNil || roundVote.Precommit != VotedNil {
+				return v.RoundsVotes[index]
+			}
+		}
+	}
+
+	if len(v.RoundsVotes) == 0 {
+		return RoundVotes{}
+	}
+
+	return v.RoundsVotes[len(v.RoundsVotes)-1]
+}
+
+func matchesValidator(validator Validator, chainValidator *ChainValidator, query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+
+	if strings.Contains(strings.ToLower(validator.Address), query) {
+		return true
+	}
+
+	return chainValidator != nil && chainValidator.Matches(query)
+}
+
+func isMyValidator(validator Validator, chainValidator *ChainValidator, myValidators MyValidators) bool {
+	if chainValidator == nil {
+		return myValidators.Matches(validator.Address, "", "")
+	}
+
+	return myValidators.Matches(validator.Address, chainValidator.Address, chainValidator.OperatorAddress)
+}
@@ -33,3 +33,16 @@ func (v Vote) Serialize(disableEmojis bool) string {
 		return ""
 	}
 }
+
+func (v Vote) String() string {
+	switch v {
+	case Voted:
+		return "for block"
+	case VotedZero:
+		return "for nil"
+	case VotedNil:
+		return "missing"
+	default:
+		return ""
+	}
+}